@@ -0,0 +1,118 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// AcronymStyle is the way to render acronyms in the cases which capitalize words, such as
+// PascalCase and camelCase. It is specified in the AcronymStyle field of Options.
+type AcronymStyle uint8
+
+const (
+	// AcronymStyleDefault renders the words in Options.Acronyms in all caps and capitalizes the
+	// other words, so "XMLHttpRequest" becomes "XMLHttpRequest" in PascalCase if "XML" is in
+	// Options.Acronyms, or "XmlHttpRequest" otherwise.
+	AcronymStyleDefault AcronymStyle = iota
+
+	// AcronymStyleCapitalize capitalizes all words including the ones in Options.Acronyms, so
+	// "XMLHttpRequest" becomes "XmlHttpRequest" in PascalCase.
+	AcronymStyleCapitalize
+
+	// AcronymStylePreserve keeps the words written in all caps in the input string in all caps and
+	// capitalizes the other words, ignoring Options.Acronyms, so "XMLHttpRequest" becomes
+	// "XMLHttpRequest" and "xml_http_request" becomes "XmlHttpRequest" in PascalCase.
+	AcronymStylePreserve
+
+	// AcronymStyleUpper renders both the words in Options.Acronyms and the words written in all
+	// caps in the input string in all caps, so "xml_HTTP_request" becomes "XMLHTTPRequest" in
+	// PascalCase if "XML" is in Options.Acronyms.
+	AcronymStyleUpper
+)
+
+// GoInitialisms returns the common initialisms which the Go lint tools expect to be written in all
+// caps in identifiers, such as "ID", "URL", and "HTTP". They can be specified in the Acronyms field
+// of Options with NewWordList, or appended to another list of acronyms. Since it returns a new
+// slice each time, modifying the result does not affect the other callers.
+func GoInitialisms() []string {
+	return append([]string(nil), goInitialisms...)
+}
+
+var goInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+	"IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS",
+	"TTL", "UDP", "UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// isAcronym reports whether the word matches one of the acronyms, ignoring case.
+func isAcronym(word string, acronyms []string) bool {
+	for _, acronym := range acronyms {
+		if strings.EqualFold(word, acronym) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAllCapsWord reports whether a word to be capitalized is rendered in all caps according to the
+// acronym style. isUpperInInput is whether the word has no lowercase letters in the input string.
+func (opts *Options) isAllCapsWord(word string, isUpperInInput bool) bool {
+	switch opts.AcronymStyle {
+	case AcronymStyleCapitalize:
+		return false
+	case AcronymStylePreserve:
+		return isUpperInInput
+	case AcronymStyleUpper:
+		return isUpperInInput || opts.isAcronym(word)
+	default:
+		return opts.isAcronym(word)
+	}
+}
+
+// isAcronym reports whether the word matches one of opts.Acronyms, ignoring case.
+func (opts *Options) isAcronym(word string) bool {
+	if opts.words != nil {
+		return opts.words.isAcronym(word)
+	}
+	return isAcronym(word, opts.Acronyms.words())
+}
+
+// pluralStem returns the word without its plural suffix "s", if the word has it and the rest is
+// longer than a letter and has no lowercase letters, like "IDs". A word written in lowercase, such
+// as "status", is not treated as a plural, even if its stem matches an acronym.
+func pluralStem(word string) (string, bool) {
+	n := len(word) - 1
+	if n < 2 || word[n] != 's' || hasLowerCase(word[:n]) {
+		return "", false
+	}
+	return word[:n], true
+}
+
+func hasLowerCase(s string) bool {
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPluralSuffix reports whether the lowercase letter at input[i:end], which follows a sequence of
+// uppercase letters starting at runStart, is a plural suffix "s" like the ones of "IDs" and
+// "APIsList". The suffix is detected when opts.AcronymPlurals is true or the uppercase letters
+// match one of opts.Acronyms, and must not be followed by a lowercase letter.
+func isPluralSuffix(input string, runStart, i, end int, opts *Options) bool {
+	if input[i] != 's' || end != i+1 {
+		return false
+	}
+	if !opts.AcronymPlurals && !opts.isAcronym(input[runStart:i]) {
+		return false
+	}
+	ch, _ := utf8.DecodeRuneInString(input[end:])
+	return !isLowerCase(ch, opts.isUnicode())
+}
@@ -0,0 +1,335 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestAcronyms(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Acronyms:                   stringcase.NewWordList(stringcase.GoInitialisms()...),
+	}
+
+	t.Run("capitalized cases", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.PascalCaseWithOptions("user_id", opts)
+		assert.Equal(t, result, "UserID")
+
+		result = stringcase.PascalCaseWithOptions("http_url", opts)
+		assert.Equal(t, result, "HTTPURL")
+
+		result = stringcase.PascalCaseWithOptions("HttpRequest", opts)
+		assert.Equal(t, result, "HTTPRequest")
+
+		result = stringcase.CamelCaseWithOptions("user_id", opts)
+		assert.Equal(t, result, "userID")
+
+		result = stringcase.AdaCaseWithOptions("json-api-client", opts)
+		assert.Equal(t, result, "JSON_API_Client")
+
+		result = stringcase.TitleCaseWithOptions("sqlQueryUuid", opts)
+		assert.Equal(t, result, "SQL Query UUID")
+
+		result = stringcase.TrainCaseWithOptions("xml_http_request", opts)
+		assert.Equal(t, result, "XML-HTTP-Request")
+
+		result = stringcase.Capitalize("dns.ttl", ' ', opts)
+		assert.Equal(t, result, "DNS TTL")
+	})
+
+	t.Run("keep the first word of camel case lowercase", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.CamelCaseWithOptions("ID_value", opts)
+		assert.Equal(t, result, "idValue")
+
+		result = stringcase.CamelCaseWithOptions("URLParser", opts)
+		assert.Equal(t, result, "urlParser")
+	})
+
+	t.Run("acronyms with digits", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.PascalCaseWithOptions("utf8_string", opts)
+		assert.Equal(t, result, "UTF8String")
+
+		opts.SeparateBeforeNonAlphabets = true
+		result = stringcase.PascalCaseWithOptions("utf8_string", opts)
+		assert.Equal(t, result, "Utf8String")
+	})
+
+	t.Run("words containing acronyms", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.PascalCaseWithOptions("userid_IDs_idx", opts)
+		assert.Equal(t, result, "UseridIDsIdx")
+
+		result = stringcase.PascalCaseWithOptions("userid_idx_ipv6", opts)
+		assert.Equal(t, result, "UseridIdxIpv6")
+	})
+
+	t.Run("lowercased and uppercased cases", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("userID", opts)
+		assert.Equal(t, result, "user_id")
+
+		result = stringcase.KebabCaseWithOptions("HTTPURLParser", opts)
+		assert.Equal(t, result, "httpurl-parser")
+
+		result = stringcase.MacroCaseWithOptions("userId", opts)
+		assert.Equal(t, result, "USER_ID")
+
+		result = stringcase.CobolCaseWithOptions("jsonApi", opts)
+		assert.Equal(t, result, "JSON-API")
+	})
+
+	t.Run("modify the Go initialisms", func(t *testing.T) {
+		initialisms := stringcase.GoInitialisms()
+		assert.Equal(t, initialisms[0], "ACL")
+		initialisms[0] = "XYZ"
+		assert.Equal(t, stringcase.GoInitialisms()[0], "ACL")
+	})
+
+	t.Run("custom acronyms", func(t *testing.T) {
+		opts := origOpts
+		acronyms := append([]string{"GraphQL", "OAuth"}, stringcase.GoInitialisms()...)
+		opts.Acronyms = stringcase.NewWordList(acronyms...)
+
+		result := stringcase.PascalCaseWithOptions("graphql_api", opts)
+		assert.Equal(t, result, "GRAPHQLAPI")
+
+		result = stringcase.TitleCaseWithOptions("oauth token", opts)
+		assert.Equal(t, result, "OAUTH Token")
+	})
+
+	t.Run("with the Unicode option", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+
+		result := stringcase.PascalCaseWithOptions("straße_id", opts)
+		assert.Equal(t, result, "StraßeID")
+
+		opts.Acronyms = stringcase.NewWordList("ÉTÉ")
+		result = stringcase.TitleCaseWithOptions("été_2024", opts)
+		assert.Equal(t, result, "ÉTÉ 2024")
+	})
+
+	t.Run("without acronyms", func(t *testing.T) {
+		opts := origOpts
+		opts.Acronyms = stringcase.WordList{}
+
+		result := stringcase.PascalCaseWithOptions("user_id", opts)
+		assert.Equal(t, result, "UserId")
+	})
+}
+
+func TestAcronymStyle(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Acronyms:                   stringcase.NewWordList("XML", "ID"),
+	}
+
+	t.Run("default", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymStyle = stringcase.AcronymStyleDefault
+
+		result := stringcase.PascalCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "XMLHttpRequest")
+
+		result = stringcase.PascalCaseWithOptions("xml_HTTP_request", opts)
+		assert.Equal(t, result, "XMLHttpRequest")
+
+		result = stringcase.CamelCaseWithOptions("request_xml", opts)
+		assert.Equal(t, result, "requestXML")
+	})
+
+	t.Run("capitalize", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymStyle = stringcase.AcronymStyleCapitalize
+
+		result := stringcase.PascalCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "XmlHttpRequest")
+
+		result = stringcase.CamelCaseWithOptions("request_XML_id", opts)
+		assert.Equal(t, result, "requestXmlId")
+
+		opts.Acronyms = stringcase.WordList{}
+		result = stringcase.TitleCaseWithOptions("XML_HTTP_request", opts)
+		assert.Equal(t, result, "Xml Http Request")
+	})
+
+	t.Run("preserve", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymStyle = stringcase.AcronymStylePreserve
+
+		result := stringcase.PascalCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "XMLHttpRequest")
+
+		result = stringcase.PascalCaseWithOptions("xml_http_request", opts)
+		assert.Equal(t, result, "XmlHttpRequest")
+
+		result = stringcase.CamelCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "xmlHttpRequest")
+
+		result = stringcase.TrainCaseWithOptions("sendXMLHTTPRequest", opts)
+		assert.Equal(t, result, "Send-XMLHTTP-Request")
+
+		result = stringcase.AdaCaseWithOptions("UTF8_string_2", opts)
+		assert.Equal(t, result, "UTF8_String_2")
+
+		opts.Acronyms = stringcase.WordList{}
+		result = stringcase.PascalCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "XMLHttpRequest")
+	})
+
+	t.Run("upper", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymStyle = stringcase.AcronymStyleUpper
+
+		result := stringcase.PascalCaseWithOptions("xml_HTTP_request", opts)
+		assert.Equal(t, result, "XMLHTTPRequest")
+
+		result = stringcase.PascalCaseWithOptions("XmlHttpRequest", opts)
+		assert.Equal(t, result, "XMLHttpRequest")
+
+		result = stringcase.CamelCaseWithOptions("user_id_XML", opts)
+		assert.Equal(t, result, "userIDXML")
+	})
+
+	t.Run("with the Unicode option", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.AcronymStyle = stringcase.AcronymStylePreserve
+
+		result := stringcase.PascalCaseWithOptions("ÉTÉ_été", opts)
+		assert.Equal(t, result, "ÉTÉÉté")
+	})
+
+	t.Run("no effect on lowercased and uppercased cases", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymStyle = stringcase.AcronymStylePreserve
+
+		result := stringcase.SnakeCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "xml_http_request")
+
+		result = stringcase.MacroCaseWithOptions("XMLHttpRequest", opts)
+		assert.Equal(t, result, "XML_HTTP_REQUEST")
+	})
+}
+
+func TestAcronymPlurals(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		AcronymPlurals:             true,
+	}
+
+	t.Run("lowercased and uppercased cases", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("userIDs", opts)
+		assert.Equal(t, result, "user_ids")
+
+		result = stringcase.KebabCaseWithOptions("APIsList", opts)
+		assert.Equal(t, result, "apis-list")
+
+		result = stringcase.MacroCaseWithOptions("URLs", opts)
+		assert.Equal(t, result, "URLS")
+
+		result = stringcase.CobolCaseWithOptions("getIDs2", opts)
+		assert.Equal(t, result, "GET-IDS2")
+
+		result = stringcase.SnakeCaseWithOptions("IDs_of_URLs", opts)
+		assert.Equal(t, result, "ids_of_urls")
+	})
+
+	t.Run("capitalized cases", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.PascalCaseWithOptions("userIDs", opts)
+		assert.Equal(t, result, "UserIds")
+
+		result = stringcase.CamelCaseWithOptions("APIsList", opts)
+		assert.Equal(t, result, "apisList")
+
+		opts.Acronyms = stringcase.NewWordList(stringcase.GoInitialisms()...)
+		result = stringcase.PascalCaseWithOptions("userIDs", opts)
+		assert.Equal(t, result, "UserIDs")
+
+		result = stringcase.CamelCaseWithOptions("list_APIs_by_IDs", opts)
+		assert.Equal(t, result, "listAPIsByIDs")
+
+		result = stringcase.TitleCaseWithOptions("HTTPS_URLs", opts)
+		assert.Equal(t, result, "HTTPS URLs")
+
+		opts.Acronyms = stringcase.WordList{}
+		opts.AcronymStyle = stringcase.AcronymStylePreserve
+		result = stringcase.PascalCaseWithOptions("userIDs_and_ids", opts)
+		assert.Equal(t, result, "UserIDsAndIds")
+	})
+
+	t.Run("not plural suffixes", func(t *testing.T) {
+		opts := origOpts
+
+		opts.Acronyms = stringcase.NewWordList("STATU", "API", "ID")
+		result := stringcase.PascalCaseWithOptions("status_code", opts)
+		assert.Equal(t, result, "StatusCode")
+
+		result = stringcase.CamelCaseWithOptions("list_apis_by_ids", opts)
+		assert.Equal(t, result, "listApisByIds")
+
+		result = stringcase.TitleCaseWithOptions("STATUS", opts)
+		assert.Equal(t, result, "Status")
+		opts.Acronyms = stringcase.WordList{}
+
+		result = stringcase.SnakeCaseWithOptions("IDsomething", opts)
+		assert.Equal(t, result, "i_dsomething")
+
+		result = stringcase.SnakeCaseWithOptions("HTTPServer", opts)
+		assert.Equal(t, result, "http_server")
+
+		result = stringcase.SnakeCaseWithOptions("IDt", opts)
+		assert.Equal(t, result, "i_dt")
+
+		result = stringcase.SnakeCaseWithOptions("As", opts)
+		assert.Equal(t, result, "as")
+
+		opts.Unicode = true
+		result = stringcase.SnakeCaseWithOptions("IDs\u0301", opts)
+		assert.Equal(t, result, "i_ds\u0301")
+	})
+
+	t.Run("plural suffixes of acronyms in the dictionary", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymPlurals = false
+		opts.Acronyms = stringcase.NewWordList("ID", "API")
+
+		result := stringcase.SnakeCaseWithOptions("userIDs", opts)
+		assert.Equal(t, result, "user_ids")
+
+		result = stringcase.KebabCaseWithOptions("APIsList", opts)
+		assert.Equal(t, result, "apis-list")
+
+		result = stringcase.KebabCaseWithOptions("URLsList", opts)
+		assert.Equal(t, result, "ur-ls-list")
+	})
+
+	t.Run("without the option", func(t *testing.T) {
+		opts := origOpts
+		opts.AcronymPlurals = false
+
+		result := stringcase.SnakeCaseWithOptions("userIDs", opts)
+		assert.Equal(t, result, "user_i_ds")
+
+		result = stringcase.KebabCaseWithOptions("APIsList", opts)
+		assert.Equal(t, result, "ap-is-list")
+	})
+}
@@ -7,7 +7,14 @@ package stringcase
 // AdaCaseWithOptions converts the input string to Ada case with the
 // specified options.
 func AdaCaseWithOptions(input string, opts Options) string {
-	return Capitalize(input, '_', opts)
+	return Format(input, styleAda, opts)
+}
+
+// AdaCaseWithMapping converts the input string to ada case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func AdaCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleAda, opts)
 }
 
 // AdaCase converts the input string to ada case.
@@ -15,8 +22,30 @@ func AdaCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func AdaCase(input string) string {
-	return Capitalize(input, '_', Options{
+	return Format(input, styleAda, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// AppendAdaCase appends the input string converted to Ada case to dst
+// and returns the extended buffer, in the same way as AdaCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendAdaCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleAda, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsAdaCaseWithOptions reports whether the input string is in Ada case
+// with the specified options, that is, whether AdaCaseWithOptions
+// returns it unchanged.
+func IsAdaCaseWithOptions(input string, opts Options) bool {
+	return AdaCaseWithOptions(input, opts) == input
+}
+
+// IsAdaCase reports whether the input string is in Ada case.
+func IsAdaCase(input string) bool {
+	return AdaCase(input) == input
+}
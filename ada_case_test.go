@@ -1512,3 +1512,82 @@ func TestAdaCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestAdaCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.AdaCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "A_B_C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.AdaCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsAdaCase(t *testing.T) {
+	t.Run("string in Ada case", func(t *testing.T) {
+		assert.True(t, stringcase.IsAdaCase("User_Account_Settings"))
+		assert.True(t, stringcase.IsAdaCase(""))
+	})
+
+	t.Run("string not in Ada case", func(t *testing.T) {
+		assert.False(t, stringcase.IsAdaCase("user_account_settings"))
+		assert.False(t, stringcase.IsAdaCase("_User_Account_Settings"))
+		assert.False(t, stringcase.IsAdaCase("Rate%_Limit"))
+	})
+}
+
+func TestIsAdaCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in Ada case", func(t *testing.T) {
+		assert.True(t, stringcase.IsAdaCaseWithOptions("Rate%_Limit", opts))
+		assert.True(t, stringcase.IsAdaCaseWithOptions("User_Account_Settings", opts))
+	})
+
+	t.Run("string not in Ada case", func(t *testing.T) {
+		assert.False(t, stringcase.IsAdaCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsAdaCaseWithOptions("_User_Account_Settings", opts))
+	})
+}
+
+func TestAppendAdaCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendAdaCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=User_Account_Settings")
+
+		buf = stringcase.AppendAdaCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "User_Account_Settings")
+	})
+
+	t.Run("same result as AdaCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendAdaCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.AdaCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendAdaCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
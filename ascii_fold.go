@@ -0,0 +1,111 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// asciiFolding is a mapping of a Latin letter without a decomposition to ASCII letters.
+type asciiFolding struct {
+	ch     rune
+	folded string
+}
+
+const (
+	minAsciiFolding = 0x00c6
+)
+
+func findAsciiFolding(ch rune) *asciiFolding {
+	if ch < minAsciiFolding {
+		return nil
+	}
+	i := sort.Search(len(asciiFoldings), func(i int) bool {
+		return asciiFoldings[i].ch >= ch
+	})
+	if i < len(asciiFoldings) && asciiFoldings[i].ch == ch {
+		return &asciiFoldings[i]
+	}
+	return nil
+}
+
+// foldToAscii replaces the Latin letters in the input string with their ASCII equivalents, such
+// as "é" with "e", "Ł" with "L", and "ß" with "ss". The combining marks following a folded letter
+// or an ASCII letter are removed. A letter folded to more than one uppercase letter is
+// titlecased when a lowercase letter follows it, so "Æsir" becomes "Aesir" instead of "AEsir".
+// The other characters are left as they are.
+func foldToAscii(input string) string {
+	if isAsciiString(input) {
+		return input
+	}
+
+	buf := make([]byte, 0, len(input))
+	isAfterLetter := false
+	for i, r := range input {
+		if r < utf8.RuneSelf {
+			buf = append(buf, byte(r))
+			isAfterLetter = isAsciiUpperCase(r) || isAsciiLowerCase(r)
+			continue
+		}
+		if isAfterLetter && unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		folded := asciiFoldOf(r)
+		if len(folded) == 0 {
+			buf = utf8.AppendRune(buf, r)
+			isAfterLetter = false
+			continue
+		}
+		if len(folded) > 1 && isAsciiUpperCase(rune(folded[1])) &&
+			isLowerCaseFollowing(input[i+utf8.RuneLen(r):]) {
+			buf = append(buf, folded[0])
+			for j := 1; j < len(folded); j++ {
+				buf = append(buf, byte(toAsciiLowerCase(rune(folded[j]))))
+			}
+		} else {
+			buf = append(buf, folded...)
+		}
+		isAfterLetter = true
+	}
+	return string(buf)
+}
+
+// asciiFoldOf returns the ASCII letters to which the letter is folded, or an empty string if the
+// letter cannot be folded.
+func asciiFoldOf(r rune) string {
+	if f := findAsciiFolding(r); f != nil {
+		return f.folded
+	}
+	if !unicode.IsLetter(r) {
+		return ""
+	}
+	d := findDecomposition(r)
+	if d == nil {
+		return ""
+	}
+	folded := make([]byte, 0, len(d.nfkd))
+	for _, c := range d.nfkd {
+		if isAsciiUpperCase(c) || isAsciiLowerCase(c) {
+			folded = append(folded, byte(c))
+		} else if !unicode.Is(unicode.Mn, c) {
+			return ""
+		}
+	}
+	return string(folded)
+}
+
+// isLowerCaseFollowing reports whether the string starts with a lowercase letter, skipping the
+// combining marks at the head.
+func isLowerCaseFollowing(s string) bool {
+	for _, r := range s {
+		if !unicode.Is(unicode.Mn, r) {
+			return unicode.IsLower(r)
+		}
+	}
+	return false
+}
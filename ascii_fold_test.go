@@ -0,0 +1,120 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestFoldToASCII(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		FoldToASCII:                true,
+	}
+
+	t.Run("fold letters with diacritics", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("Crème Brûlée", opts)
+		assert.Equal(t, result, "creme_brulee")
+
+		result = stringcase.KebabCaseWithOptions("crème_brûlée", opts)
+		assert.Equal(t, result, "creme-brulee")
+
+		result = stringcase.PascalCaseWithOptions("crème_brûlée", opts)
+		assert.Equal(t, result, "CremeBrulee")
+
+		result = stringcase.CamelCaseWithOptions("Łódź Kraków", opts)
+		assert.Equal(t, result, "lodzKrakow")
+
+		result = stringcase.MacroCaseWithOptions("ÇaVaÀÉtéÎle", opts)
+		assert.Equal(t, result, "CA_VA_A_ETE_ILE")
+
+		result = stringcase.SnakeCaseWithOptions("fooBar", opts)
+		assert.Equal(t, result, "foo_bar")
+	})
+
+	t.Run("fold letters followed by combining marks", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("cre\u0300meBru\u0302le\u0301e", opts)
+		assert.Equal(t, result, "creme_brulee")
+
+		result = stringcase.SnakeCaseWithOptions("\u00f8\u0301ya", opts)
+		assert.Equal(t, result, "oya")
+
+		result = stringcase.SnakeCaseWithOptions("\u00de\u0301\u00f3r", opts)
+		assert.Equal(t, result, "thor")
+	})
+
+	t.Run("fold letters without decompositions", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("Æsir", opts)
+		assert.Equal(t, result, "aesir")
+
+		result = stringcase.TitleCaseWithOptions("ÆSIR_Æ", opts)
+		assert.Equal(t, result, "Aesir Ae")
+
+		result = stringcase.TrainCaseWithOptions("Œuvre", opts)
+		assert.Equal(t, result, "Oeuvre")
+
+		result = stringcase.AdaCaseWithOptions("straße_ÞórShavn", opts)
+		assert.Equal(t, result, "Strasse_Thor_Shavn")
+
+		result = stringcase.CobolCaseWithOptions("Øresund đaković", opts)
+		assert.Equal(t, result, "ORESUND-DAKOVIC")
+
+		result = stringcase.SnakeCaseWithOptions("\u0244\ua7cdlaw", opts)
+		assert.Equal(t, result, "uslaw")
+	})
+
+	t.Run("fold compatibility characters", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("ﬁleＮame", opts)
+		assert.Equal(t, result, "file_name")
+
+		result = stringcase.PascalCaseWithOptions("Ǆemal_ǅemal", opts)
+		assert.Equal(t, result, "DzemalDzemal")
+	})
+
+	t.Run("keep characters of other scripts", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("crèmeΑθήνα", opts)
+		assert.Equal(t, result, "creme")
+
+		opts.Unicode = true
+		result = stringcase.SnakeCaseWithOptions("crèmeΑθήνα", opts)
+		assert.Equal(t, result, "creme_αθήνα")
+
+		opts.Keep = "①"
+		result = stringcase.SnakeCaseWithOptions("①\u0301fooBar", opts)
+		assert.Equal(t, result, "①\u0301_foo_bar")
+	})
+
+	t.Run("with capitalize, lowerize, and upperize", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.Capitalize("crème brûlée", '.', opts)
+		assert.Equal(t, result, "Creme.Brulee")
+
+		result = stringcase.Lowerize("Crème Brûlée", '.', opts)
+		assert.Equal(t, result, "creme.brulee")
+
+		result = stringcase.Upperize("Crème Brûlée", '.', opts)
+		assert.Equal(t, result, "CREME.BRULEE")
+	})
+
+	t.Run("without folding", func(t *testing.T) {
+		opts := origOpts
+		opts.FoldToASCII = false
+
+		result := stringcase.SnakeCaseWithOptions("Crème Brûlée", opts)
+		assert.Equal(t, result, "cr_me_br_l_e")
+	})
+}
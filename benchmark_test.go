@@ -53,6 +53,14 @@ func BenchmarkPascalCase(b *testing.B) {
 	}
 }
 
+// sentence case
+
+func BenchmarkSentenceCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.SentenceCase("foo-bar100%baz")
+	}
+}
+
 // snake case
 
 func BenchmarkSnakeCase(b *testing.B) {
@@ -69,6 +77,13 @@ func BenchmarkTitleCase(b *testing.B) {
 	}
 }
 
+func BenchmarkTitleCase_titleStyle(b *testing.B) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, TitleStyle: stringcase.TitleStyleChicago}
+	for i := 0; i < b.N; i++ {
+		stringcase.TitleCaseWithOptions("the lord of the rings", opts)
+	}
+}
+
 // train case
 
 func BenchmarkTrainCase(b *testing.B) {
@@ -77,6 +92,208 @@ func BenchmarkTrainCase(b *testing.B) {
 	}
 }
 
+// dot case
+
+func BenchmarkDotCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCase("foo-bar100%baz")
+	}
+}
+
+// path case
+
+func BenchmarkPathCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCase("foo-bar100%baz")
+	}
+}
+
+// flat case
+
+func BenchmarkFlatCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCase("foo-bar100%baz")
+	}
+}
+
+// upper flat case
+
+func BenchmarkUpperFlatCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCase("foo-bar100%baz")
+	}
+}
+
+// camel snake case
+
+func BenchmarkCamelSnakeCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCase("foo-bar100%baz")
+	}
+}
+
+// lower space case
+
+func BenchmarkLowerSpaceCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCase("foo-bar100%baz")
+	}
+}
+
+// upper space case
+
+func BenchmarkUpperSpaceCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCase("foo-bar100%baz")
+	}
+}
+
+// detect case
+
+func BenchmarkDetectCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		stringcase.DetectCase("fooBar100Baz")
+	}
+}
+
+// append functions
+
+func BenchmarkAppendSnakeCase(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = stringcase.AppendSnakeCase(buf[:0], "foo-bar100%baz")
+	}
+}
+
+func BenchmarkAppendPascalCase(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = stringcase.AppendPascalCase(buf[:0], "foo-bar100%baz")
+	}
+}
+
+func BenchmarkAppendSentenceCase(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = stringcase.AppendSentenceCase(buf[:0], "foo-bar100%baz")
+	}
+}
+
+func BenchmarkAppendCapitalize(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+	for i := 0; i < b.N; i++ {
+		buf = stringcase.AppendCapitalize(buf[:0], "foo-bar100%baz", '.', opts)
+	}
+}
+
+// converter
+
+func BenchmarkConverter(b *testing.B) {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+	for i := 0; i < b.N; i++ {
+		cv.Convert("foo-bar100%baz")
+	}
+}
+
+func BenchmarkConverter_withSeparators(b *testing.B) {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-_",
+	})
+	for i := 0; i < b.N; i++ {
+		cv.Convert("foo-bar100%baz")
+	}
+}
+
+func BenchmarkConverter_withKeep(b *testing.B) {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	})
+	for i := 0; i < b.N; i++ {
+		cv.Convert("foo-bar100%baz")
+	}
+}
+
+func BenchmarkConverter_Append(b *testing.B) {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	})
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = cv.Append(buf[:0], "foo-bar100%baz")
+	}
+}
+
+func BenchmarkSnakeCase_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.SnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// manySymbols is a Keep string which has many ASCII and non-ASCII symbols, such as the ones
+// allowed in file names.
+const manySymbols = "!#$&'()+,.;=@[]^`{}~%\u00b0\u2103\u00a7\u00b6"
+
+func BenchmarkConverter_withManySymbols(b *testing.B) {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       manySymbols,
+	})
+	for i := 0; i < b.N; i++ {
+		cv.Convert("foo-bar100%baz\u2103qux_quux")
+	}
+}
+
+func BenchmarkConverter_withAcronyms(b *testing.B) {
+	cv := stringcase.NewConverter(stringcase.CasePascal, stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Acronyms:                   stringcase.NewWordList(stringcase.GoInitialisms()...),
+		ProtectedWords:             stringcase.NewWordList("iOS", "OAuth2", "k8s"),
+	})
+	for i := 0; i < b.N; i++ {
+		cv.Convert("user_id-oauth2_http_server-ios_app")
+	}
+}
+
+func BenchmarkPascalCase_withAcronyms(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Acronyms:                   stringcase.NewWordList(stringcase.GoInitialisms()...),
+		ProtectedWords:             stringcase.NewWordList("iOS", "OAuth2", "k8s"),
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PascalCaseWithOptions("user_id-oauth2_http_server-ios_app", opts)
+	}
+}
+
+func BenchmarkSnakeCase_withManySymbols(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       manySymbols,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.SnakeCaseWithOptions("foo-bar100%baz\u2103qux_quux", opts)
+	}
+}
+
 // ada case with options
 
 func BenchmarkAdaCase_nonAlphabetsAsHead(b *testing.B) {
@@ -1147,3 +1364,836 @@ func BenchmarkTrainCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
 		stringcase.TrainCaseWithOptions("foo-bar100%baz", opts)
 	}
 }
+
+// dot case with options
+
+func BenchmarkDotCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkDotCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.DotCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// path case with options
+
+func BenchmarkPathCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkPathCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.PathCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// flat case with options
+
+func BenchmarkFlatCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkFlatCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.FlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// upper flat case with options
+
+func BenchmarkUpperFlatCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperFlatCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperFlatCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// camel snake case with options
+
+func BenchmarkCamelSnakeCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkCamelSnakeCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.CamelSnakeCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// lower space case with options
+
+func BenchmarkLowerSpaceCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkLowerSpaceCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.LowerSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+
+// upper space case with options
+
+func BenchmarkUpperSpaceCase_nonAlphabetsAsHead(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsTail(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsWord(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsPart(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsHead_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsTail_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsWord_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsPart_withSeparators(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Separators:                 "-",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsHead_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsTail_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsWord_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: true,
+		SeparateAfterNonAlphabets:  true,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
+func BenchmarkUpperSpaceCase_nonAlphabetsAsPart_withKeep(b *testing.B) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  false,
+		Keep:                       "%",
+	}
+	for i := 0; i < b.N; i++ {
+		stringcase.UpperSpaceCaseWithOptions("foo-bar100%baz", opts)
+	}
+}
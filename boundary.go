@@ -0,0 +1,159 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Boundary is a set of the kinds of word boundaries. It is specified in the Boundaries field of
+// Options, and the bits of the kinds of boundaries to detect are combined with the | operator.
+//
+// Separators always split words regardless of this set.
+type Boundary uint16
+
+const (
+	// BoundaryLowerUpper is the boundary between a lowercase letter and an uppercase letter, like
+	// "foo|Bar".
+	BoundaryLowerUpper Boundary = 1 << iota
+
+	// BoundaryAcronym is the boundary before the last uppercase letter of a sequence of uppercase
+	// letters which is followed by a lowercase letter, like "HTTP|Server".
+	BoundaryAcronym
+
+	// BoundaryLetterDigit is the boundary between a letter and a digit, like "utf|8".
+	BoundaryLetterDigit
+
+	// BoundaryDigitLetter is the boundary between a digit and a letter, like "v2|Api".
+	BoundaryDigitLetter
+
+	// BoundaryBeforeSymbol is the boundary between a letter or a digit and a kept symbol, like
+	// "foo|%".
+	BoundaryBeforeSymbol
+
+	// BoundaryAfterSymbol is the boundary between a kept symbol and a letter or a digit, like
+	// "%|foo".
+	BoundaryAfterSymbol
+
+	// BoundaryNone is a set which has no kinds of boundaries, so only separators split words. It
+	// is distinguished from the zero value, which means that the boundaries are decided by
+	// SeparateBeforeNonAlphabets and SeparateAfterNonAlphabets of Options.
+	BoundaryNone
+)
+
+// BoundarySymbol is the boundaries on both sides of kept symbols.
+const BoundarySymbol = BoundaryBeforeSymbol | BoundaryAfterSymbol
+
+// The classes of the characters, which decide the kinds of word boundaries between them.
+const (
+	charIsUpper uint8 = iota
+	charIsLower
+	charIsCaseless
+	charIsDigit
+	charIsKeptMark
+	charIsSepMark
+)
+
+// asciiClasses is the classes of ASCII letters and digits, which do not depend on the options.
+// The other ASCII characters are charIsSepMark here, and are classified with the options.
+var asciiClasses = func() (classes [utf8.RuneSelf]uint8) {
+	for ch := range classes {
+		switch {
+		case isAsciiUpperCase(rune(ch)):
+			classes[ch] = charIsUpper
+		case isAsciiLowerCase(rune(ch)):
+			classes[ch] = charIsLower
+		case isAsciiDigit(rune(ch)):
+			classes[ch] = charIsDigit
+		default:
+			classes[ch] = charIsSepMark
+		}
+	}
+	return
+}()
+
+// charClassOf returns the class of the character, which is classified with the options in the same
+// way as in the conversion functions.
+func charClassOf(ch rune, opts *Options) uint8 {
+	if ch < utf8.RuneSelf {
+		if class := asciiClasses[ch]; class != charIsSepMark {
+			return class
+		}
+	} else if opts.isUnicode() {
+		switch {
+		case isUnicodeUpperCase(ch):
+			return charIsUpper
+		case isUnicodeLowerCase(ch):
+			return charIsLower
+		case opts.SeparateScripts && unicode.IsLetter(ch):
+			return charIsCaseless
+		case isUnicodeDigit(ch):
+			return charIsDigit
+		}
+	}
+
+	switch {
+	case opts.marks != nil:
+		if opts.marks.isKept(ch) {
+			return charIsKeptMark
+		}
+	case len(opts.Separators) > 0:
+		if !strings.ContainsRune(opts.Separators, ch) {
+			return charIsKeptMark
+		}
+	case len(opts.Keep) > 0:
+		if strings.ContainsRune(opts.Keep, ch) {
+			return charIsKeptMark
+		}
+	}
+	return charIsSepMark
+}
+
+// isWordBoundary reports whether a word boundary is placed between a character of the class prev
+// and a following character of the class next. If opts.Boundaries is zero, the boundaries between
+// letters and non-alphabetic characters are decided by opts.SeparateBeforeNonAlphabets and
+// opts.SeparateAfterNonAlphabets. The boundary before the last uppercase letter of an acronym is
+// not decided here but by separatesAcronyms, because it depends on the following character.
+func (opts *Options) isWordBoundary(prev, next uint8) bool {
+	isPrevLetter := prev <= charIsCaseless
+	isNextLetter := next <= charIsCaseless
+
+	if opts.Boundaries == 0 {
+		switch {
+		case isPrevLetter && isNextLetter:
+			return prev != charIsUpper && next == charIsUpper
+		case isNextLetter:
+			return opts.SeparateAfterNonAlphabets
+		case isPrevLetter:
+			return opts.SeparateBeforeNonAlphabets
+		default:
+			return false
+		}
+	}
+
+	b := opts.Boundaries
+	switch {
+	case isPrevLetter && isNextLetter:
+		return prev != charIsUpper && next == charIsUpper && b&BoundaryLowerUpper != 0
+	case prev == next:
+		return false
+	case prev == charIsKeptMark:
+		return b&BoundaryAfterSymbol != 0
+	case next == charIsKeptMark:
+		return b&BoundaryBeforeSymbol != 0
+	case prev == charIsDigit:
+		return b&BoundaryDigitLetter != 0
+	default:
+		return b&BoundaryLetterDigit != 0
+	}
+}
+
+// separatesAcronyms reports whether a word boundary is placed before the last uppercase letter of
+// a sequence of uppercase letters which is followed by a lowercase letter.
+func (opts *Options) separatesAcronyms() bool {
+	return opts.Boundaries == 0 || opts.Boundaries&BoundaryAcronym != 0
+}
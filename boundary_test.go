@@ -0,0 +1,147 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestBoundaries(t *testing.T) {
+	t.Run("lower-upper, acronym, and digit-letter", func(t *testing.T) {
+		opts := stringcase.Options{
+			Boundaries: stringcase.BoundaryLowerUpper | stringcase.BoundaryAcronym |
+				stringcase.BoundaryDigitLetter,
+		}
+
+		result := stringcase.SnakeCaseWithOptions("utf8Decoder", opts)
+		assert.Equal(t, result, "utf8_decoder")
+
+		result = stringcase.SnakeCaseWithOptions("v2Api", opts)
+		assert.Equal(t, result, "v2_api")
+
+		result = stringcase.PascalCaseWithOptions("HTTPServer_v2api", opts)
+		assert.Equal(t, result, "HttpServerV2Api")
+
+		result = stringcase.CamelCaseWithOptions("foo-bar100baz", opts)
+		assert.Equal(t, result, "fooBar100Baz")
+	})
+
+	t.Run("letter-digit", func(t *testing.T) {
+		opts := stringcase.Options{Boundaries: stringcase.BoundaryLetterDigit}
+
+		result := stringcase.KebabCaseWithOptions("utf8Decoder", opts)
+		assert.Equal(t, result, "utf-8decoder")
+
+		result = stringcase.MacroCaseWithOptions("abc123def", opts)
+		assert.Equal(t, result, "ABC_123DEF")
+	})
+
+	t.Run("without camel humps", func(t *testing.T) {
+		opts := stringcase.Options{Boundaries: stringcase.BoundaryNone}
+
+		result := stringcase.SnakeCaseWithOptions("FooBar", opts)
+		assert.Equal(t, result, "foobar")
+
+		result = stringcase.SnakeCaseWithOptions("FooBar_Baz100qux", opts)
+		assert.Equal(t, result, "foobar_baz100qux")
+
+		result = stringcase.TitleCaseWithOptions("FooBar baz", opts)
+		assert.Equal(t, result, "Foobar Baz")
+
+		result = stringcase.CamelCaseWithOptions("fooBar_baz", opts)
+		assert.Equal(t, result, "foobarBaz")
+
+		result = stringcase.PascalCaseWithOptions("fooBar_baz", opts)
+		assert.Equal(t, result, "FoobarBaz")
+
+		result = stringcase.MacroCaseWithOptions("fooBar_baz", opts)
+		assert.Equal(t, result, "FOOBAR_BAZ")
+
+		opts.Boundaries = stringcase.BoundaryAcronym
+		result = stringcase.SnakeCaseWithOptions("fooBarHTTPServer", opts)
+		assert.Equal(t, result, "foobarhttp_server")
+
+		opts.Boundaries = stringcase.BoundaryLowerUpper
+		result = stringcase.SnakeCaseWithOptions("fooBarHTTPServer", opts)
+		assert.Equal(t, result, "foo_bar_httpserver")
+	})
+
+	t.Run("symbols", func(t *testing.T) {
+		opts := stringcase.Options{Keep: "%#", Boundaries: stringcase.BoundarySymbol}
+
+		result := stringcase.SnakeCaseWithOptions("foo%bar", opts)
+		assert.Equal(t, result, "foo_%_bar")
+
+		result = stringcase.SnakeCaseWithOptions("100%#1", opts)
+		assert.Equal(t, result, "100_%#_1")
+
+		opts.Boundaries = stringcase.BoundaryBeforeSymbol
+		result = stringcase.SnakeCaseWithOptions("foo%bar", opts)
+		assert.Equal(t, result, "foo_%bar")
+
+		opts.Boundaries = stringcase.BoundaryAfterSymbol
+		result = stringcase.SnakeCaseWithOptions("foo%bar", opts)
+		assert.Equal(t, result, "foo%_bar")
+
+		opts.Boundaries = stringcase.BoundaryLetterDigit | stringcase.BoundaryDigitLetter
+		result = stringcase.SnakeCaseWithOptions("foo%bar1%", opts)
+		assert.Equal(t, result, "foo%bar_1%")
+	})
+
+	t.Run("separators always split words", func(t *testing.T) {
+		opts := stringcase.Options{Boundaries: stringcase.BoundaryNone}
+
+		result := stringcase.KebabCaseWithOptions("__foo__bar__", opts)
+		assert.Equal(t, result, "foo-bar")
+
+		opts.Separators = "-"
+		result = stringcase.KebabCaseWithOptions("foo-bar_baz", opts)
+		assert.Equal(t, result, "foo-bar_baz")
+	})
+
+	t.Run("ignore the legacy options", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+			Boundaries:                 stringcase.BoundaryLowerUpper,
+		}
+
+		result := stringcase.SnakeCaseWithOptions("fooBar100baz", opts)
+		assert.Equal(t, result, "foo_bar100baz")
+	})
+
+	t.Run("with other options", func(t *testing.T) {
+		opts := stringcase.Options{
+			Boundaries:     stringcase.BoundaryAcronym,
+			AcronymPlurals: true,
+		}
+
+		result := stringcase.SnakeCaseWithOptions("APIsList", opts)
+		assert.Equal(t, result, "apislist")
+
+		result = stringcase.SnakeCaseWithOptions("IDsX", opts)
+		assert.Equal(t, result, "idsx")
+
+		opts.Boundaries = stringcase.BoundaryLowerUpper
+		result = stringcase.SnakeCaseWithOptions("APIsList", opts)
+		assert.Equal(t, result, "apis_list")
+
+		opts = stringcase.Options{
+			Boundaries:      stringcase.BoundaryLowerUpper | stringcase.BoundaryAcronym,
+			Unicode:         true,
+			Locale:          stringcase.LocaleDutch,
+			SeparateScripts: true,
+		}
+		result = stringcase.SnakeCaseWithOptions("IJslandÉtéキーID", opts)
+		assert.Equal(t, result, "ijsland_été_キー_id")
+
+		result = stringcase.SnakeCaseWithOptions("iJsselMeer", opts)
+		assert.Equal(t, result, "ijssel_meer")
+
+		opts = stringcase.Options{Boundaries: stringcase.BoundaryLowerUpper, Unicode: true}
+		result = stringcase.SnakeCaseWithOptions("\u039f\u0394\u039f\u03a3Xyz", opts)
+		assert.Equal(t, result, "\u03bf\u03b4\u03bf\u03c3xyz")
+	})
+}
@@ -4,80 +4,17 @@
 
 package stringcase
 
-import (
-	"strings"
-)
-
 // CamelCaseWithOptions converts the input string to camel case with the
 // specified options.
 func CamelCaseWithOptions(input string, opts Options) string {
-	result := make([]rune, 0, len(input))
-
-	const (
-		ChIsFirstOfStr = iota
-		ChIsNextOfUpper
-		ChIsNextOfContdUpper
-		ChIsNextOfSepMark
-		ChIsNextOfKeptMark
-		ChIsOther
-	)
-	var flag uint8 = ChIsFirstOfStr
-
-	for _, ch := range input {
-		if isAsciiUpperCase(ch) {
-			if flag == ChIsFirstOfStr {
-				result = append(result, toAsciiLowerCase(ch))
-				flag = ChIsNextOfUpper
-			} else if flag == ChIsNextOfUpper || flag == ChIsNextOfContdUpper ||
-				(!opts.SeparateAfterNonAlphabets && flag == ChIsNextOfKeptMark) {
-				result = append(result, toAsciiLowerCase(ch))
-				flag = ChIsNextOfContdUpper
-			} else {
-				result = append(result, ch)
-				flag = ChIsNextOfUpper
-			}
-		} else if isAsciiLowerCase(ch) {
-			if flag == ChIsNextOfContdUpper {
-				n := len(result)
-				prev := result[n-1]
-				if isAsciiLowerCase(prev) {
-					prev = toAsciiUpperCase(prev)
-				}
-				result[n-1] = prev
-				result = append(result, ch)
-			} else if flag == ChIsNextOfSepMark ||
-				(opts.SeparateAfterNonAlphabets && flag == ChIsNextOfKeptMark) {
-				result = append(result, toAsciiUpperCase(ch))
-			} else {
-				result = append(result, ch)
-			}
-			flag = ChIsOther
-		} else {
-			isKeptChar := false
-			if isAsciiDigit(ch) {
-				isKeptChar = true
-			} else if len(opts.Separators) > 0 {
-				if !strings.ContainsRune(opts.Separators, ch) {
-					isKeptChar = true
-				}
-			} else if len(opts.Keep) > 0 {
-				if strings.ContainsRune(opts.Keep, ch) {
-					isKeptChar = true
-				}
-			}
-
-			if isKeptChar {
-				result = append(result, ch)
-				flag = ChIsNextOfKeptMark
-			} else {
-				if flag != ChIsFirstOfStr {
-					flag = ChIsNextOfSepMark
-				}
-			}
-		}
-	}
+	return Format(input, styleCamel, opts)
+}
 
-	return string(result)
+// CamelCaseWithMapping converts the input string to camel case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func CamelCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleCamel, opts)
 }
 
 // CamelCase converts the input string to camel case.
@@ -85,8 +22,30 @@ func CamelCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func CamelCase(input string) string {
-	return CamelCaseWithOptions(input, Options{
+	return Format(input, styleCamel, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendCamelCase appends the input string converted to camel case to dst
+// and returns the extended buffer, in the same way as CamelCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendCamelCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleCamel, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// IsCamelCaseWithOptions reports whether the input string is in camel case
+// with the specified options, that is, whether CamelCaseWithOptions
+// returns it unchanged.
+func IsCamelCaseWithOptions(input string, opts Options) bool {
+	return CamelCaseWithOptions(input, opts) == input
+}
+
+// IsCamelCase reports whether the input string is in camel case.
+func IsCamelCase(input string) bool {
+	return CamelCase(input) == input
+}
@@ -1286,3 +1286,82 @@ func TestCamelCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestCamelCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.CamelCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "aBC1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 1, InStart: 2, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 3},
+			{OutStart: 2, OutEnd: 2, InStart: 3, InEnd: 4},
+			{OutStart: 2, OutEnd: 3, InStart: 4, InEnd: 5},
+			{OutStart: 3, OutEnd: 4, InStart: 5, InEnd: 6},
+			{OutStart: 4, OutEnd: 4, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.CamelCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsCamelCase(t *testing.T) {
+	t.Run("string in camel case", func(t *testing.T) {
+		assert.True(t, stringcase.IsCamelCase("userAccountSettings"))
+		assert.True(t, stringcase.IsCamelCase(""))
+	})
+
+	t.Run("string not in camel case", func(t *testing.T) {
+		assert.False(t, stringcase.IsCamelCase("user_account_settings"))
+		assert.False(t, stringcase.IsCamelCase("userAccountSettings_"))
+		assert.False(t, stringcase.IsCamelCase("rate%Limit"))
+	})
+}
+
+func TestIsCamelCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in camel case", func(t *testing.T) {
+		assert.True(t, stringcase.IsCamelCaseWithOptions("rate%Limit", opts))
+		assert.True(t, stringcase.IsCamelCaseWithOptions("userAccountSettings", opts))
+	})
+
+	t.Run("string not in camel case", func(t *testing.T) {
+		assert.False(t, stringcase.IsCamelCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsCamelCaseWithOptions("userAccountSettings_", opts))
+	})
+}
+
+func TestAppendCamelCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendCamelCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=userAccountSettings")
+
+		buf = stringcase.AppendCamelCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "userAccountSettings")
+	})
+
+	t.Run("same result as CamelCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendCamelCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.CamelCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendCamelCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
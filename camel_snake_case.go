@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// CamelSnakeCaseWithOptions converts the input string to camel snake case with the
+// specified options.
+func CamelSnakeCaseWithOptions(input string, opts Options) string {
+	return Format(input, styleCamelSnake, opts)
+}
+
+// CamelSnakeCaseWithMapping converts the input string to camel snake case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func CamelSnakeCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleCamelSnake, opts)
+}
+
+// CamelSnakeCase converts the input string to camel snake case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func CamelSnakeCase(input string) string {
+	return Format(input, styleCamelSnake, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendCamelSnakeCase appends the input string converted to camel snake case to dst
+// and returns the extended buffer, in the same way as CamelSnakeCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendCamelSnakeCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleCamelSnake, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsCamelSnakeCaseWithOptions reports whether the input string is in camel snake case
+// with the specified options, that is, whether CamelSnakeCaseWithOptions
+// returns it unchanged.
+func IsCamelSnakeCaseWithOptions(input string, opts Options) bool {
+	return CamelSnakeCaseWithOptions(input, opts) == input
+}
+
+// IsCamelSnakeCase reports whether the input string is in camel snake case.
+func IsCamelSnakeCase(input string) bool {
+	return CamelSnakeCase(input) == input
+}
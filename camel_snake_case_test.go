@@ -0,0 +1,1343 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestCamelSnakeCase(t *testing.T) {
+	t.Run("convert camelCase", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("abcDefGHIjk")
+		assert.Equal(t, result, "abc_Def_Gh_Ijk")
+	})
+
+	t.Run("convert PascalCase", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("AbcDefGHIjk")
+		assert.Equal(t, result, "abc_Def_Gh_Ijk")
+	})
+
+	t.Run("convert snake_case", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("abc_def_ghi")
+		assert.Equal(t, result, "abc_Def_Ghi")
+	})
+
+	t.Run("convert kebab-case", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("abc-def-ghi")
+		assert.Equal(t, result, "abc_Def_Ghi")
+	})
+
+	t.Run("convert Train-Case", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("Abc-Def-Ghi")
+		assert.Equal(t, result, "abc_Def_Ghi")
+	})
+
+	t.Run("convert MACRO_CASE", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("ABC_DEF_GHI")
+		assert.Equal(t, result, "abc_Def_Ghi")
+	})
+
+	t.Run("convert COBOL-CASE", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("ABC-DEF-GHI")
+		assert.Equal(t, result, "abc_Def_Ghi")
+	})
+
+	t.Run("convert with keeping digits", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("abc123-456defG89HIJklMN12")
+		assert.Equal(t, result, "abc123_456_Def_G89_Hi_Jkl_Mn12")
+	})
+
+	t.Run("convert with symbols as seperators", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase(":.abc~!@def#$ghi%&jk(lm)no/?")
+		assert.Equal(t, result, "abc_Def_Ghi_Jk_Lm_No")
+	})
+
+	t.Run("convert when starting with digit", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("123abc456def")
+		assert.Equal(t, result, "123_Abc456_Def")
+
+		result = stringcase.CamelSnakeCase("123ABC456DEF")
+		assert.Equal(t, result, "123_Abc456_Def")
+
+		result = stringcase.CamelSnakeCase("123Abc456Def")
+		assert.Equal(t, result, "123_Abc456_Def")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.CamelSnakeCase("")
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestCamelSnakeCaseWithOptions(t *testing.T) {
+	t.Run("non-alphabets as head of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123_456def_G_89hi_Jkl_Mn_12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc_Def_Ghi_Jk_Lm_No")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc_456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc_456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123_456_Def_G89_Hi_Jkl_Mn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc_Def_Ghi_Jk_Lm_No")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123_456_Def_G_89_Hi_Jkl_Mn_12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc_Def_Ghi_Jk_Lm_No")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123_456def_G89hi_Jkl_Mn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc_Def_Ghi_Jk_Lm_No")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc__def__ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_-def_-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc__def__ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_-def_-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123_456def_G_89hi_Jkl_Mn_12")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123-456def_G_89hi_Jkl_Mn_12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc_~!_Def_#_Ghi_%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc_456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc_456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc_123def")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc__Def__Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc__Def__Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123_456_Def_G89_Hi_Jkl_Mn12")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456_Def_G89_Hi_Jkl_Mn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "._Abc~!_Def#_Ghi%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123_Def")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc___Def___Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc___Def___Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123_456_Def_G_89_Hi_Jkl_Mn_12")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123-456_Def_G_89_Hi_Jkl_Mn_12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "._Abc_~!_Def_#_Ghi_%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc_123_Def")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123_456def_G89hi_Jkl_Mn12")
+
+			opts.Separators = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456def_G89hi_Jkl_Mn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!_Def#_Ghi%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123def")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc__def__ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_-def_-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc__def__ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_-def_-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123_456def_G_89hi_Jkl_Mn_12")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123-456def_G_89hi_Jkl_Mn_12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc_456def")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc_456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc_~!_Def_#_Ghi_%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc__Def__Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc__Def__Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123_456_Def_G89_Hi_Jkl_Mn12")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456_Def_G89_Hi_Jkl_Mn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123_Abc456_Def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "._Abc~!_Def#_Ghi%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc___Def___Ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc___Def___Ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_-_Def_-_Ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123_456_Def_G_89_Hi_Jkl_Mn_12")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc_123-456_Def_G_89_Hi_Jkl_Mn_12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123_Abc_456_Def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "._Abc_~!_Def_#_Ghi_%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc_Def_Gh_Ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-_Def-_Ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "_"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc_Def_Ghi")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123_456def_G89hi_Jkl_Mn12")
+
+			opts.Keep = "-"
+			result = stringcase.CamelSnakeCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456def_G89hi_Jkl_Mn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.CamelSnakeCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.CamelSnakeCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.CamelSnakeCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!_Def#_Ghi%_Jk_Lm_No_?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.CamelSnakeCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+}
+
+func TestCamelSnakeCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.CamelSnakeCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "a_B_C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.CamelSnakeCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsCamelSnakeCase(t *testing.T) {
+	t.Run("string in camel snake case", func(t *testing.T) {
+		assert.True(t, stringcase.IsCamelSnakeCase("user_Account_Settings"))
+		assert.True(t, stringcase.IsCamelSnakeCase(""))
+	})
+
+	t.Run("string not in camel snake case", func(t *testing.T) {
+		assert.False(t, stringcase.IsCamelSnakeCase("user_account_settings"))
+		assert.False(t, stringcase.IsCamelSnakeCase("_user_Account_Settings"))
+		assert.False(t, stringcase.IsCamelSnakeCase("rate%_Limit"))
+	})
+}
+
+func TestIsCamelSnakeCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in camel snake case", func(t *testing.T) {
+		assert.True(t, stringcase.IsCamelSnakeCaseWithOptions("rate%_Limit", opts))
+		assert.True(t, stringcase.IsCamelSnakeCaseWithOptions("user_Account_Settings", opts))
+	})
+
+	t.Run("string not in camel snake case", func(t *testing.T) {
+		assert.False(t, stringcase.IsCamelSnakeCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsCamelSnakeCaseWithOptions("_user_Account_Settings", opts))
+	})
+}
+
+func TestAppendCamelSnakeCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendCamelSnakeCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=user_Account_Settings")
+
+		buf = stringcase.AppendCamelSnakeCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "user_Account_Settings")
+	})
+
+	t.Run("same result as CamelSnakeCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendCamelSnakeCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.CamelSnakeCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendCamelSnakeCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
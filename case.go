@@ -0,0 +1,211 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Case is one of the case styles which this library provides conversion functions for.
+//
+// Case implements encoding.TextMarshaler and encoding.TextUnmarshaler, so it can be read from and
+// written to JSON, YAML and other text formats by its name, and flag.Value, so it can be set by a
+// command line flag. The names are parsed with ParseCase.
+type Case uint8
+
+const (
+	// CaseUnknown is the zero value of Case, which represents no case style.
+	CaseUnknown Case = iota
+
+	CaseAda
+	CaseCamel
+	CaseCamelSnake
+	CaseCobol
+	CaseDot
+	CaseFlat
+	CaseKebab
+	CaseLowerSpace
+	CaseMacro
+	CasePascal
+	CasePath
+	CaseSentence
+	CaseSnake
+	CaseTitle
+	CaseTrain
+	CaseUpperFlat
+	CaseUpperSpace
+
+	numCases
+)
+
+// caseDefs holds the names, the conversion functions, and the styles of the cases, indexed by
+// Case. Sentence case has no style, since its words are cased by sentenceRules.
+var caseDefs = [numCases]struct {
+	name    string
+	convert func(string, Options) string
+	style   Style
+}{
+	CaseUnknown:    {name: "unknown"},
+	CaseAda:        {"Ada_Case", AdaCaseWithOptions, styleAda},
+	CaseCamel:      {"camelCase", CamelCaseWithOptions, styleCamel},
+	CaseCamelSnake: {"camel_Snake_Case", CamelSnakeCaseWithOptions, styleCamelSnake},
+	CaseCobol:      {"COBOL-CASE", CobolCaseWithOptions, styleCobol},
+	CaseDot:        {"dot.case", DotCaseWithOptions, styleDot},
+	CaseFlat:       {"flatcase", FlatCaseWithOptions, styleFlat},
+	CaseKebab:      {"kebab-case", KebabCaseWithOptions, styleKebab},
+	CaseLowerSpace: {"lower space case", LowerSpaceCaseWithOptions, styleLowerSpace},
+	CaseMacro:      {"MACRO_CASE", MacroCaseWithOptions, styleMacro},
+	CasePascal:     {"PascalCase", PascalCaseWithOptions, stylePascal},
+	CasePath:       {"path/case", PathCaseWithOptions, stylePath},
+	CaseSentence:   {name: "Sentence case", convert: SentenceCaseWithOptions},
+	CaseSnake:      {"snake_case", SnakeCaseWithOptions, styleSnake},
+	CaseTitle:      {"Title Case", TitleCaseWithOptions, styleTitle},
+	CaseTrain:      {"Train-Case", TrainCaseWithOptions, styleTrain},
+	CaseUpperFlat:  {"UPPERFLATCASE", UpperFlatCaseWithOptions, styleUpperFlat},
+	CaseUpperSpace: {"UPPER SPACE CASE", UpperSpaceCaseWithOptions, styleUpperSpace},
+}
+
+// String returns the name of the case written in the case itself, such as "snake_case" and
+// "PascalCase", or "unknown" for CaseUnknown. For a value out of the constants, it returns a
+// string like "Case(99)".
+func (c Case) String() string {
+	if c >= numCases {
+		return "Case(" + strconv.Itoa(int(c)) + ")"
+	}
+	return caseDefs[c].name
+}
+
+// caseAliases maps the names of the cases, which are lowercased and stripped of the separators
+// ' ', '-', '_', '.', and '/', to the cases.
+var caseAliases = map[string]Case{
+	"unknown":            CaseUnknown,
+	"ada":                CaseAda,
+	"adacase":            CaseAda,
+	"camel":              CaseCamel,
+	"camelcase":          CaseCamel,
+	"lowercamel":         CaseCamel,
+	"lowercamelcase":     CaseCamel,
+	"camelsnake":         CaseCamelSnake,
+	"camelsnakecase":     CaseCamelSnake,
+	"cobol":              CaseCobol,
+	"cobolcase":          CaseCobol,
+	"screamingkebab":     CaseCobol,
+	"screamingkebabcase": CaseCobol,
+	"upperkebab":         CaseCobol,
+	"upperkebabcase":     CaseCobol,
+	"dot":                CaseDot,
+	"dotcase":            CaseDot,
+	"flat":               CaseFlat,
+	"flatcase":           CaseFlat,
+	"lowerflat":          CaseFlat,
+	"lowerflatcase":      CaseFlat,
+	"kebab":              CaseKebab,
+	"kebabcase":          CaseKebab,
+	"dash":               CaseKebab,
+	"dashcase":           CaseKebab,
+	"lisp":               CaseKebab,
+	"lispcase":           CaseKebab,
+	"spinal":             CaseKebab,
+	"spinalcase":         CaseKebab,
+	"lowerspace":         CaseLowerSpace,
+	"lowerspacecase":     CaseLowerSpace,
+	"space":              CaseLowerSpace,
+	"spacecase":          CaseLowerSpace,
+	"macro":              CaseMacro,
+	"macrocase":          CaseMacro,
+	"screamingsnake":     CaseMacro,
+	"screamingsnakecase": CaseMacro,
+	"uppersnake":         CaseMacro,
+	"uppersnakecase":     CaseMacro,
+	"constant":           CaseMacro,
+	"constantcase":       CaseMacro,
+	"pascal":             CasePascal,
+	"pascalcase":         CasePascal,
+	"uppercamel":         CasePascal,
+	"uppercamelcase":     CasePascal,
+	"path":               CasePath,
+	"pathcase":           CasePath,
+	"sentence":           CaseSentence,
+	"sentencecase":       CaseSentence,
+	"snake":              CaseSnake,
+	"snakecase":          CaseSnake,
+	"title":              CaseTitle,
+	"titlecase":          CaseTitle,
+	"train":              CaseTrain,
+	"traincase":          CaseTrain,
+	"httpheader":         CaseTrain,
+	"httpheadercase":     CaseTrain,
+	"upperflat":          CaseUpperFlat,
+	"upperflatcase":      CaseUpperFlat,
+	"screamingflat":      CaseUpperFlat,
+	"screamingflatcase":  CaseUpperFlat,
+	"upperspace":         CaseUpperSpace,
+	"upperspacecase":     CaseUpperSpace,
+	"screamingspace":     CaseUpperSpace,
+	"screamingspacecase": CaseUpperSpace,
+}
+
+// ParseCase returns the case with the name. The name is matched ignoring case and the separators
+// ' ', '-', '_', '.', and '/', so all of "snake_case", "snake", and "SNAKE CASE" are parsed to
+// CaseSnake. In addition to the names returned by String and the names without the trailing
+// "case", the common aliases are accepted, such as "SCREAMING_SNAKE" and "CONSTANT_CASE" for
+// MACRO_CASE, "lowerCamelCase" for camelCase, "UpperCamelCase" for PascalCase,
+// "SCREAMING-KEBAB" for COBOL-CASE, and "spinal-case" and "lisp-case" for kebab-case.
+// It returns an error if the name is not one of them.
+func ParseCase(name string) (Case, error) {
+	key := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '.', '/':
+			return -1
+		}
+		return toLowerCase(r)
+	}, name)
+	if c, ok := caseAliases[key]; ok {
+		return c, nil
+	}
+	return CaseUnknown, errors.New("unknown case name " + strconv.Quote(name))
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns the name returned by String, or an
+// error if the case is not one of the Case constants.
+func (c Case) MarshalText() ([]byte, error) {
+	if c >= numCases {
+		return nil, errors.New("invalid case " + c.String())
+	}
+	return []byte(caseDefs[c].name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It parses the text with ParseCase.
+func (c *Case) UnmarshalText(text []byte) error {
+	parsed, err := ParseCase(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// Set implements flag.Value. It parses the name with ParseCase.
+func (c *Case) Set(name string) error {
+	return c.UnmarshalText([]byte(name))
+}
+
+// Convert converts the input string to the case with the specified options, in the same way as
+// the 〜CaseWithOptions function of the case, such as SnakeCaseWithOptions for CaseSnake. If the
+// case is CaseUnknown or not one of the Case constants, the input string is returned unchanged.
+func Convert(input string, to Case, opts Options) string {
+	if to == CaseUnknown || to >= numCases {
+		return input
+	}
+	return caseDefs[to].convert(input, opts)
+}
+
+// defaultOptions is the options used by the 〜Case functions which do not take Options.
+var defaultOptions = Options{
+	SeparateBeforeNonAlphabets: false,
+	SeparateAfterNonAlphabets:  true,
+}
@@ -0,0 +1,195 @@
+package stringcase_test
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestCase_String(t *testing.T) {
+	t.Run("names of the cases", func(t *testing.T) {
+		assert.Equal(t, stringcase.CaseAda.String(), "Ada_Case")
+		assert.Equal(t, stringcase.CaseCamel.String(), "camelCase")
+		assert.Equal(t, stringcase.CaseCamelSnake.String(), "camel_Snake_Case")
+		assert.Equal(t, stringcase.CaseCobol.String(), "COBOL-CASE")
+		assert.Equal(t, stringcase.CaseDot.String(), "dot.case")
+		assert.Equal(t, stringcase.CaseFlat.String(), "flatcase")
+		assert.Equal(t, stringcase.CaseKebab.String(), "kebab-case")
+		assert.Equal(t, stringcase.CaseLowerSpace.String(), "lower space case")
+		assert.Equal(t, stringcase.CaseMacro.String(), "MACRO_CASE")
+		assert.Equal(t, stringcase.CasePascal.String(), "PascalCase")
+		assert.Equal(t, stringcase.CasePath.String(), "path/case")
+		assert.Equal(t, stringcase.CaseSentence.String(), "Sentence case")
+		assert.Equal(t, stringcase.CaseSnake.String(), "snake_case")
+		assert.Equal(t, stringcase.CaseTitle.String(), "Title Case")
+		assert.Equal(t, stringcase.CaseTrain.String(), "Train-Case")
+		assert.Equal(t, stringcase.CaseUpperFlat.String(), "UPPERFLATCASE")
+		assert.Equal(t, stringcase.CaseUpperSpace.String(), "UPPER SPACE CASE")
+	})
+
+	t.Run("unknown cases", func(t *testing.T) {
+		assert.Equal(t, stringcase.CaseUnknown.String(), "unknown")
+		assert.Equal(t, stringcase.Case(99).String(), "Case(99)")
+	})
+}
+
+func TestParseCase(t *testing.T) {
+	t.Run("parse the names of the cases", func(t *testing.T) {
+		for c := stringcase.CaseUnknown; c <= stringcase.CaseUpperSpace; c++ {
+			parsed, err := stringcase.ParseCase(c.String())
+			assert.Nil(t, err)
+			assert.Equal(t, parsed, c)
+		}
+	})
+
+	t.Run("parse the names ignoring case and separators", func(t *testing.T) {
+		names := map[string]stringcase.Case{
+			"snake":             stringcase.CaseSnake,
+			"SNAKE CASE":        stringcase.CaseSnake,
+			"_snake_case_":      stringcase.CaseSnake,
+			"kebab":             stringcase.CaseKebab,
+			"Kebab-Case":        stringcase.CaseKebab,
+			"camel":             stringcase.CaseCamel,
+			"camel.snake":       stringcase.CaseCamelSnake,
+			"pascal_case":       stringcase.CasePascal,
+			"path/case":         stringcase.CasePath,
+			"upper_space_case":  stringcase.CaseUpperSpace,
+			"upperFlatCase":     stringcase.CaseUpperFlat,
+			"SentenceCase":      stringcase.CaseSentence,
+			"title":             stringcase.CaseTitle,
+			"ada":               stringcase.CaseAda,
+			"cobol":             stringcase.CaseCobol,
+			"train":             stringcase.CaseTrain,
+			"dot":               stringcase.CaseDot,
+			"flat":              stringcase.CaseFlat,
+			"lower-space":       stringcase.CaseLowerSpace,
+			"macro":             stringcase.CaseMacro,
+			"SCREAMING_SNAKE":   stringcase.CaseMacro,
+			"CONSTANT_CASE":     stringcase.CaseMacro,
+			"SCREAMING-KEBAB":   stringcase.CaseCobol,
+			"lowerCamelCase":    stringcase.CaseCamel,
+			"UpperCamelCase":    stringcase.CasePascal,
+			"spinal-case":       stringcase.CaseKebab,
+			"lisp-case":         stringcase.CaseKebab,
+			"HTTP-Header-Case":  stringcase.CaseTrain,
+			"SCREAMINGFLATCASE": stringcase.CaseUpperFlat,
+		}
+		for name, c := range names {
+			parsed, err := stringcase.ParseCase(name)
+			assert.Nil(t, err, name)
+			assert.Equal(t, parsed, c, name)
+		}
+	})
+
+	t.Run("fail to parse unknown names", func(t *testing.T) {
+		for _, name := range []string{"", "snek_case", "Case(99)", "snake case case"} {
+			parsed, err := stringcase.ParseCase(name)
+			assert.Equal(t, parsed, stringcase.CaseUnknown, name)
+			assert.Equal(t, err.Error(), "unknown case name \""+name+"\"", name)
+		}
+	})
+}
+
+func TestCase_MarshalText(t *testing.T) {
+	t.Run("marshal to the names", func(t *testing.T) {
+		text, err := stringcase.CaseMacro.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, string(text), "MACRO_CASE")
+
+		text, err = stringcase.CaseUnknown.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, string(text), "unknown")
+	})
+
+	t.Run("fail to marshal an invalid case", func(t *testing.T) {
+		text, err := stringcase.Case(99).MarshalText()
+		assert.Nil(t, text)
+		assert.Equal(t, err.Error(), "invalid case Case(99)")
+	})
+
+	t.Run("marshal to JSON", func(t *testing.T) {
+		config := struct {
+			Target stringcase.Case `json:"target"`
+		}{Target: stringcase.CaseKebab}
+		data, err := json.Marshal(config)
+		assert.Nil(t, err)
+		assert.Equal(t, string(data), `{"target":"kebab-case"}`)
+
+		data, err = json.Marshal(map[stringcase.Case]string{stringcase.CaseSnake: "db"})
+		assert.Nil(t, err)
+		assert.Equal(t, string(data), `{"snake_case":"db"}`)
+	})
+}
+
+func TestCase_UnmarshalText(t *testing.T) {
+	t.Run("unmarshal from the names", func(t *testing.T) {
+		var c stringcase.Case
+		assert.Nil(t, c.UnmarshalText([]byte("SCREAMING_SNAKE")))
+		assert.Equal(t, c, stringcase.CaseMacro)
+	})
+
+	t.Run("fail to unmarshal an unknown name", func(t *testing.T) {
+		c := stringcase.CaseSnake
+		err := c.UnmarshalText([]byte("snek"))
+		assert.Equal(t, err.Error(), `unknown case name "snek"`)
+		assert.Equal(t, c, stringcase.CaseSnake)
+	})
+
+	t.Run("unmarshal from JSON", func(t *testing.T) {
+		var config struct {
+			Target stringcase.Case `json:"target"`
+		}
+		err := json.Unmarshal([]byte(`{"target":"kebab"}`), &config)
+		assert.Nil(t, err)
+		assert.Equal(t, config.Target, stringcase.CaseKebab)
+
+		err = json.Unmarshal([]byte(`{"target":"snek"}`), &config)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestCase_Set(t *testing.T) {
+	t.Run("set by a command line flag", func(t *testing.T) {
+		c := stringcase.CaseSnake
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&c, "case", "the target case")
+		err := fs.Parse([]string{"-case", "PascalCase"})
+		assert.Nil(t, err)
+		assert.Equal(t, c, stringcase.CasePascal)
+	})
+
+	t.Run("fail to set an unknown name", func(t *testing.T) {
+		c := stringcase.CaseSnake
+		err := c.Set("snek")
+		assert.Equal(t, err.Error(), `unknown case name "snek"`)
+		assert.Equal(t, c, stringcase.CaseSnake)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+
+	t.Run("convert to each case", func(t *testing.T) {
+		for c := stringcase.CaseAda; c <= stringcase.CaseUpperSpace; c++ {
+			result := stringcase.Convert("userAccount_settings", c, opts)
+			assert.True(t, stringcase.CheckCase(result, c, opts) == nil, c.String())
+		}
+		assert.Equal(t, stringcase.Convert("userAccount_settings", stringcase.CaseKebab, opts), "user-account-settings")
+		assert.Equal(t, stringcase.Convert("userAccount_settings", stringcase.CaseSentence, opts), "User account settings")
+	})
+
+	t.Run("convert with options", func(t *testing.T) {
+		keep := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+		result := stringcase.Convert("rate%limit", stringcase.CaseSnake, keep)
+		assert.Equal(t, result, "rate%_limit")
+	})
+
+	t.Run("return the input for unknown cases", func(t *testing.T) {
+		assert.Equal(t, stringcase.Convert("userAccount", stringcase.CaseUnknown, opts), "userAccount")
+		assert.Equal(t, stringcase.Convert("userAccount", stringcase.Case(99), opts), "userAccount")
+	})
+}
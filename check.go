@@ -0,0 +1,108 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CaseError is the error returned by CheckCase when a string is not in a case style. Offset is the
+// byte offset of the first character which differs from the conversion result, and Reason
+// describes the character, such as "uppercase letter" and "double joiner". Offset is -1 when the
+// string cannot be checked because Case is unknown.
+type CaseError struct {
+	Input  string
+	Case   Case
+	Offset int
+	Reason string
+}
+
+func (e *CaseError) Error() string {
+	if e.Offset < 0 {
+		return strconv.Quote(e.Input) + " cannot be checked: " + e.Reason
+	}
+	return strconv.Quote(e.Input) + " is not " + e.Case.String() + ": " + e.Reason +
+		" at byte " + strconv.Itoa(e.Offset)
+}
+
+// CheckCase reports whether the input string is in the case style c with the specified options,
+// in the same way as the Is〜CaseWithOptions functions, and returns a *CaseError which tells the
+// first offending position and the reason if it is not, or nil if it is. For example, checking
+// "user_Name" in snake_case returns an error with the reason "uppercase letter at byte 5", and
+// checking "user__name" returns one with "double joiner at byte 5". Non-ASCII letters are
+// separators unless opts.Unicode is true, so checking "naïve" in kebab-case without it returns one
+// with "non-ASCII letter 'ï' instead of joiner '-' at byte 2". If c is CaseUnknown or not one of
+// the Case constants, it returns an error with the offset -1.
+func CheckCase(input string, c Case, opts Options) error {
+	if c == CaseUnknown || c >= numCases {
+		return &CaseError{Input: input, Case: c, Offset: -1, Reason: "unknown case"}
+	}
+	expected := caseDefs[c].convert(input, opts)
+	if expected == input {
+		return nil
+	}
+
+	i := 0
+	for i < len(input) && i < len(expected) && input[i] == expected[i] {
+		i++
+	}
+	for i > 0 && i < len(input) && !utf8.RuneStart(input[i]) {
+		i--
+	}
+	reason := diffReason(input, expected, i, &opts)
+	return &CaseError{Input: input, Case: c, Offset: i, Reason: reason}
+}
+
+// diffReason describes the character at the byte offset i of the input string, where the input
+// string first differs from the expected conversion result. The character is classified by its
+// kind in the input string: a separator which is dropped or replaced with a joiner, a letter in a
+// wrong case, or a character before which a joiner is missing.
+func diffReason(input, expected string, i int, opts *Options) string {
+	ch, _ := utf8.DecodeRuneInString(input[i:])
+	want, _ := utf8.DecodeRuneInString(expected[i:])
+	isJoinerExpected := i < len(expected) && charClassOf(want, opts) == charIsSepMark
+
+	switch class := charClassOf(ch, opts); {
+	case class == charIsSepMark:
+		prev, _ := utf8.DecodeLastRuneInString(input[:i])
+		name := "separator"
+		switch {
+		case unicode.IsLetter(ch) && opts.isUnicode():
+			name = "uncased letter"
+		case unicode.IsLetter(ch):
+			name = "non-ASCII letter"
+		case i == 0:
+			return "leading separator"
+		case !hasWordUnit(input[i:], opts):
+			return "trailing separator"
+		case charClassOf(prev, opts) == charIsSepMark:
+			return "double joiner"
+		}
+		if isJoinerExpected {
+			return fmt.Sprintf("%s %q instead of joiner %q", name, ch, want)
+		}
+		return fmt.Sprintf("dropped %s %q", name, ch)
+	case isJoinerExpected:
+		return "missing joiner"
+	case unicode.ToLower(ch) != unicode.ToLower(want):
+		return fmt.Sprintf("character %q instead of %q", ch, want)
+	case class == charIsUpper:
+		return "uppercase letter"
+	}
+	return "lowercase letter"
+}
+
+// hasWordUnit reports whether the string includes a character which is not a separator.
+func hasWordUnit(str string, opts *Options) bool {
+	for _, ch := range str {
+		if charClassOf(ch, opts) != charIsSepMark {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package stringcase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestCheckCase(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+
+	t.Run("return nil if the string is in the case", func(t *testing.T) {
+		assert.Nil(t, stringcase.CheckCase("user_name", stringcase.CaseSnake, opts))
+		assert.Nil(t, stringcase.CheckCase("userName", stringcase.CaseCamel, opts))
+		assert.Nil(t, stringcase.CheckCase("User name", stringcase.CaseSentence, opts))
+		assert.Nil(t, stringcase.CheckCase("", stringcase.CaseMacro, opts))
+	})
+
+	t.Run("report a case error", func(t *testing.T) {
+		err := stringcase.CheckCase("user_Name", stringcase.CaseSnake, opts)
+		var ce *stringcase.CaseError
+		assert.True(t, errors.As(err, &ce))
+		assert.Equal(t, ce.Input, "user_Name")
+		assert.Equal(t, ce.Case, stringcase.CaseSnake)
+		assert.Equal(t, ce.Offset, 5)
+		assert.Equal(t, ce.Reason, "uppercase letter")
+		assert.Equal(t, err.Error(), `"user_Name" is not snake_case: uppercase letter at byte 5`)
+	})
+
+	t.Run("report letters in a wrong case", func(t *testing.T) {
+		err := stringcase.CheckCase("UserName", stringcase.CaseMacro, opts)
+		assert.Equal(t, err.Error(), `"UserName" is not MACRO_CASE: lowercase letter at byte 1`)
+
+		err = stringcase.CheckCase("Foo bar", stringcase.CaseTitle, opts)
+		assert.Equal(t, err.Error(), `"Foo bar" is not Title Case: lowercase letter at byte 4`)
+
+		err = stringcase.CheckCase("USERNAME", stringcase.CasePascal, opts)
+		assert.Equal(t, err.Error(), `"USERNAME" is not PascalCase: uppercase letter at byte 1`)
+	})
+
+	t.Run("report separators", func(t *testing.T) {
+		err := stringcase.CheckCase("_user", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"_user" is not snake_case: leading separator at byte 0`)
+
+		err = stringcase.CheckCase("user__", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"user__" is not snake_case: trailing separator at byte 4`)
+
+		err = stringcase.CheckCase("user__name", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"user__name" is not snake_case: double joiner at byte 5`)
+
+		err = stringcase.CheckCase("user-name", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"user-name" is not snake_case: separator '-' instead of joiner '_' at byte 4`)
+
+		err = stringcase.CheckCase("user_name", stringcase.CaseCamel, opts)
+		assert.Equal(t, err.Error(), `"user_name" is not camelCase: dropped separator '_' at byte 4`)
+	})
+
+	t.Run("report missing joiners", func(t *testing.T) {
+		err := stringcase.CheckCase("user_2fa", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"user_2fa" is not snake_case: missing joiner at byte 6`)
+
+		err = stringcase.CheckCase("userName", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"userName" is not snake_case: missing joiner at byte 4`)
+
+		err = stringcase.CheckCase("UserName", stringcase.CaseTrain, stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+		})
+		assert.Equal(t, err.Error(), `"UserName" is not Train-Case: missing joiner at byte 4`)
+	})
+
+	t.Run("honor options", func(t *testing.T) {
+		keep := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+		assert.Nil(t, stringcase.CheckCase("rate%_limit", stringcase.CaseSnake, keep))
+
+		err := stringcase.CheckCase("rate%limit", stringcase.CaseSnake, keep)
+		assert.Equal(t, err.Error(), `"rate%limit" is not snake_case: missing joiner at byte 5`)
+
+		err = stringcase.CheckCase("rate%limit", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"rate%limit" is not snake_case: separator '%' instead of joiner '_' at byte 4`)
+
+		unicode := stringcase.Options{SeparateAfterNonAlphabets: true, Unicode: true}
+		err = stringcase.CheckCase("\u00c9COLE", stringcase.CaseSnake, unicode)
+		assert.Equal(t, err.Error(), "\"\u00c9COLE\" is not snake_case: uppercase letter at byte 0")
+
+		err = stringcase.CheckCase("caf\u00e9_\u00e9cole", stringcase.CaseCamel, unicode)
+		assert.Equal(t, err.Error(), "\"caf\u00e9_\u00e9cole\" is not camelCase: dropped separator '_' at byte 5")
+
+		err = stringcase.CheckCase("\u00e9cole", stringcase.CasePascal, unicode)
+		assert.Equal(t, err.Error(), "\"\u00e9cole\" is not PascalCase: lowercase letter at byte 0")
+
+		err = stringcase.CheckCase("café", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(), `"café" is not snake_case: dropped non-ASCII letter 'é' at byte 3`)
+	})
+
+	t.Run("report non-ASCII characters", func(t *testing.T) {
+		err := stringcase.CheckCase("us\u00e9r", stringcase.CaseSnake, opts)
+		assert.Equal(t, err.Error(),
+			"\"us\u00e9r\" is not snake_case: non-ASCII letter '\u00e9' instead of joiner '_' at byte 2")
+
+		err = stringcase.CheckCase("na\u00efve", stringcase.CaseKebab, opts)
+		assert.Equal(t, err.Error(),
+			"\"na\u00efve\" is not kebab-case: non-ASCII letter '\u00ef' instead of joiner '-' at byte 2")
+
+		err = stringcase.CheckCase("na\u00efve", stringcase.CaseCamel, opts)
+		assert.Equal(t, err.Error(),
+			"\"na\u00efve\" is not camelCase: dropped non-ASCII letter '\u00ef' at byte 2")
+
+		unicode := stringcase.Options{SeparateAfterNonAlphabets: true, Unicode: true}
+		assert.Nil(t, stringcase.CheckCase("na\u00efve", stringcase.CaseKebab, unicode))
+
+		err = stringcase.CheckCase("\u00c9cole", stringcase.CaseKebab, unicode)
+		assert.Equal(t, err.Error(), "\"\u00c9cole\" is not kebab-case: uppercase letter at byte 0")
+
+		err = stringcase.CheckCase("Na\u00efve", stringcase.CaseMacro, unicode)
+		assert.Equal(t, err.Error(), "\"Na\u00efve\" is not MACRO_CASE: lowercase letter at byte 1")
+
+		err = stringcase.CheckCase("ab\u3042c", stringcase.CaseSnake, unicode)
+		assert.Equal(t, err.Error(),
+			"\"ab\u3042c\" is not snake_case: uncased letter '\u3042' instead of joiner '_' at byte 2")
+
+		unicode.FoldToASCII = true
+		err = stringcase.CheckCase("na\u00efve", stringcase.CaseKebab, unicode)
+		assert.Equal(t, err.Error(),
+			"\"na\u00efve\" is not kebab-case: character '\u00ef' instead of 'i' at byte 2")
+	})
+
+	t.Run("report an unknown case", func(t *testing.T) {
+		err := stringcase.CheckCase("user", stringcase.CaseUnknown, opts)
+		assert.Equal(t, err.Error(), `"user" cannot be checked: unknown case`)
+
+		err = stringcase.CheckCase("user", stringcase.Case(99), opts)
+		assert.Equal(t, err.(*stringcase.CaseError).Offset, -1)
+		assert.Equal(t, err.Error(), `"user" cannot be checked: unknown case`)
+	})
+}
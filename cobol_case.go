@@ -7,7 +7,14 @@ package stringcase
 // CobolCaseWithOptions converts the input string to cobol case with the
 // specified options.
 func CobolCaseWithOptions(input string, opts Options) string {
-	return Upperize(input, '-', opts)
+	return Format(input, styleCobol, opts)
+}
+
+// CobolCaseWithMapping converts the input string to cobol case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func CobolCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleCobol, opts)
 }
 
 // CobolCase converts the input string to cobol case.
@@ -15,8 +22,30 @@ func CobolCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func CobolCase(input string) string {
-	return Upperize(input, '-', Options{
+	return Format(input, styleCobol, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// AppendCobolCase appends the input string converted to cobol case to dst
+// and returns the extended buffer, in the same way as CobolCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendCobolCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleCobol, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsCobolCaseWithOptions reports whether the input string is in cobol case
+// with the specified options, that is, whether CobolCaseWithOptions
+// returns it unchanged.
+func IsCobolCaseWithOptions(input string, opts Options) bool {
+	return CobolCaseWithOptions(input, opts) == input
+}
+
+// IsCobolCase reports whether the input string is in cobol case.
+func IsCobolCase(input string) bool {
+	return CobolCase(input) == input
+}
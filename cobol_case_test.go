@@ -1256,3 +1256,82 @@ func TestCobolCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestCobolCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.CobolCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "A-B-C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.CobolCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsCobolCase(t *testing.T) {
+	t.Run("string in cobol case", func(t *testing.T) {
+		assert.True(t, stringcase.IsCobolCase("USER-ACCOUNT-SETTINGS"))
+		assert.True(t, stringcase.IsCobolCase(""))
+	})
+
+	t.Run("string not in cobol case", func(t *testing.T) {
+		assert.False(t, stringcase.IsCobolCase("user_account_settings"))
+		assert.False(t, stringcase.IsCobolCase("USER-ACCOUNT-SETTINGS_"))
+		assert.False(t, stringcase.IsCobolCase("RATE%-LIMIT"))
+	})
+}
+
+func TestIsCobolCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in cobol case", func(t *testing.T) {
+		assert.True(t, stringcase.IsCobolCaseWithOptions("RATE%-LIMIT", opts))
+		assert.True(t, stringcase.IsCobolCaseWithOptions("USER-ACCOUNT-SETTINGS", opts))
+	})
+
+	t.Run("string not in cobol case", func(t *testing.T) {
+		assert.False(t, stringcase.IsCobolCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsCobolCaseWithOptions("USER-ACCOUNT-SETTINGS_", opts))
+	})
+}
+
+func TestAppendCobolCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendCobolCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=USER-ACCOUNT-SETTINGS")
+
+		buf = stringcase.AppendCobolCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "USER-ACCOUNT-SETTINGS")
+	})
+
+	t.Run("same result as CobolCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendCobolCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.CobolCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendCobolCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
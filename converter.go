@@ -0,0 +1,201 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Converter converts strings to a case with fixed options. The style of the case and the lookups
+// of Options.Separators, Options.Keep, Options.Acronyms, and Options.ProtectedWords are
+// precompiled when it is created, so it is faster than the 〜CaseWithOptions functions when the
+// same options are used repeatedly. A Converter is immutable and safe for concurrent use by
+// multiple goroutines.
+type Converter struct {
+	to         Case
+	opts       Options
+	style      Style
+	minorWords [][]string
+}
+
+// NewConverter creates a Converter which converts strings to the case with the options.
+func NewConverter(to Case, opts Options) *Converter {
+	cv := &Converter{to: to, opts: opts}
+	cv.opts.marks = newMarkSet(&opts)
+	cv.opts.words = newWordSet(&opts)
+	if to < numCases {
+		cv.style = caseDefs[to].style
+	}
+	if to == CaseTitle && opts.hasTitleRules() {
+		cv.minorWords = opts.titleMinorWords()
+	}
+	return cv
+}
+
+// Case returns the case which the Converter converts strings to.
+func (cv *Converter) Case() Case {
+	return cv.to
+}
+
+// convertBufferSize is the size of the buffer on the stack in which Converter.Convert builds
+// results, so the conversion of a string which fits in it allocates memory only for the result.
+const convertBufferSize = 256
+
+// Convert converts the input string in the same way as Convert with the case and the options of
+// the Converter.
+func (cv *Converter) Convert(input string) string {
+	if cv.to == CaseUnknown || cv.to >= numCases {
+		return input
+	}
+	var stack [convertBufferSize]byte
+	buf := stack[:0]
+	n := len(cv.style.Prefix) + len(input) + len(input)/2 + len(cv.style.Suffix)
+	if n > len(stack) {
+		buf = make([]byte, 0, n)
+	}
+	return string(cv.Append(buf, input))
+}
+
+// Append appends the result of Convert to dst and returns the extended buffer. Like the
+// Append〜 functions, it does not allocate memory when dst has enough capacity, unless
+// Options.Normalization or Options.FoldToASCII changes the input string. If the case of the
+// Converter is unknown, the input string is appended unchanged.
+func (cv *Converter) Append(dst []byte, input string) []byte {
+	if cv.to == CaseUnknown || cv.to >= numCases {
+		return append(dst, input...)
+	}
+	input = cv.opts.preprocess(input)
+	switch {
+	case cv.to == CaseSentence:
+		return appendSentence(dst, input, &cv.opts)
+	case cv.minorWords != nil:
+		return appendWords(dst, input, &cv.opts, WordCaseTitle, WordCaseTitle, " ", nil,
+			newTitleRules(input, cv.minorWords))
+	}
+	return appendStyle(dst, input, &cv.style, &cv.opts, nil)
+}
+
+// markSet is the precompiled set of the symbols in Options.Separators or Options.Keep.
+type markSet struct {
+	isSeparators bool
+	runes        runeSet
+}
+
+// newMarkSet returns the markSet of the options, or nil if neither Options.Separators nor
+// Options.Keep is specified.
+func newMarkSet(opts *Options) *markSet {
+	if len(opts.Separators) > 0 {
+		return &markSet{isSeparators: true, runes: newRuneSet(opts.Separators)}
+	}
+	if len(opts.Keep) > 0 {
+		return &markSet{runes: newRuneSet(opts.Keep)}
+	}
+	return nil
+}
+
+// isKept reports whether a symbol is kept in words.
+func (m *markSet) isKept(ch rune) bool {
+	return m.runes.contains(ch) != m.isSeparators
+}
+
+// runeSet is a set of runes with a 128-bit bitmap for ASCII characters and a sorted slice for
+// the other runes.
+type runeSet struct {
+	ascii  [2]uint64
+	others []rune
+}
+
+func newRuneSet(s string) runeSet {
+	var set runeSet
+	for _, ch := range s {
+		if ch < utf8.RuneSelf {
+			set.ascii[ch>>6] |= 1 << (ch & 63)
+		} else {
+			set.others = append(set.others, ch)
+		}
+	}
+	sort.Slice(set.others, func(i, j int) bool { return set.others[i] < set.others[j] })
+	return set
+}
+
+func (set *runeSet) contains(ch rune) bool {
+	if ch < utf8.RuneSelf {
+		return set.ascii[ch>>6]&(1<<(ch&63)) != 0
+	}
+	i := sort.Search(len(set.others), func(i int) bool { return set.others[i] >= ch })
+	return i < len(set.others) && set.others[i] == ch
+}
+
+// wordSet is the precompiled lookups of Options.Acronyms and Options.ProtectedWords. The acronyms
+// are indexed by their fold keys, and the protected words by the fold keys of their first runes,
+// so they are matched ignoring case in the same way as strings.EqualFold.
+type wordSet struct {
+	acronyms  map[string]struct{}
+	protected map[rune][]string
+}
+
+// newWordSet returns the wordSet of the options, or nil if neither Options.Acronyms nor
+// Options.ProtectedWords is specified.
+func newWordSet(opts *Options) *wordSet {
+	acronyms, protected := opts.Acronyms.words(), opts.ProtectedWords.words()
+	if len(acronyms) == 0 && len(protected) == 0 {
+		return nil
+	}
+	set := &wordSet{
+		acronyms:  make(map[string]struct{}, len(acronyms)),
+		protected: make(map[rune][]string),
+	}
+	for _, acronym := range acronyms {
+		set.acronyms[string(appendFoldKey(nil, acronym))] = struct{}{}
+	}
+	for _, w := range protected {
+		if len(w) > 0 {
+			ch, _ := utf8.DecodeRuneInString(w)
+			set.protected[foldRune(ch)] = append(set.protected[foldRune(ch)], w)
+		}
+	}
+	return set
+}
+
+// isAcronym reports whether the word matches one of the acronyms, ignoring case.
+func (set *wordSet) isAcronym(word string) bool {
+	var buf [32]byte
+	_, ok := set.acronyms[string(appendFoldKey(buf[:0], word))]
+	return ok
+}
+
+// protectedWords returns the protected words which may match the string starting with the rune,
+// in the order in Options.ProtectedWords.
+func (set *wordSet) protectedWords(ch rune) []string {
+	return set.protected[foldRune(ch)]
+}
+
+// appendFoldKey appends the fold key of s to dst. Two strings have the same fold key if and only
+// if they are equal under strings.EqualFold.
+func appendFoldKey(dst []byte, s string) []byte {
+	for _, ch := range s {
+		dst = utf8.AppendRune(dst, foldRune(ch))
+	}
+	return dst
+}
+
+// foldRune returns the smallest rune in the orbit of unicode.SimpleFold which contains ch.
+func foldRune(ch rune) rune {
+	if ch < utf8.RuneSelf {
+		if 'a' <= ch && ch <= 'z' {
+			ch -= 'a' - 'A'
+		}
+		return ch
+	}
+	min := ch
+	for r := unicode.SimpleFold(ch); r != ch; r = unicode.SimpleFold(r) {
+		if r < min {
+			min = r
+		}
+	}
+	return min
+}
@@ -0,0 +1,169 @@
+package stringcase_test
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestConverter(t *testing.T) {
+	t.Run("convert to the case with the options", func(t *testing.T) {
+		opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+		cv := stringcase.NewConverter(stringcase.CaseSnake, opts)
+		assert.Equal(t, cv.Case(), stringcase.CaseSnake)
+		assert.Equal(t, cv.Convert("foo-Bar100%baz"), "foo_bar100%_baz")
+		assert.Equal(t, cv.Convert(""), "")
+	})
+
+	t.Run("convert with separators", func(t *testing.T) {
+		opts := stringcase.Options{SeparateAfterNonAlphabets: true, Separators: "-\u3000"}
+		cv := stringcase.NewConverter(stringcase.CasePascal, opts)
+		assert.Equal(t, cv.Convert("foo-bar100%baz\u3000qux\u30fbquux"), "FooBar100%BazQux\u30fbQuux")
+	})
+
+	t.Run("convert with non-ASCII symbols to keep", func(t *testing.T) {
+		opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "\u00b0\u2103%"}
+		cv := stringcase.NewConverter(stringcase.CaseKebab, opts)
+		assert.Equal(t, cv.Convert("temp20\u2103Max30\u00b0F\u2109"), "temp20\u2103-max30\u00b0-f")
+	})
+
+	t.Run("match acronyms and protected words ignoring case", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateAfterNonAlphabets: true,
+			Acronyms:                  stringcase.NewWordList("ID", "SSL"),
+			ProtectedWords:            stringcase.NewWordList("iOS", "k8s"),
+			Unicode:                   true,
+		}
+		cv := stringcase.NewConverter(stringcase.CasePascal, opts)
+		assert.Equal(t, cv.Convert("user_id-ssl"), "UserIDSSL")
+		assert.Equal(t, cv.Convert("user_\u0131d-\u017fsl"), "UserIdSSL")
+		assert.Equal(t, cv.Convert("IOS_K8S-\u212a8s"), "iOSk8s\u212a8S")
+		assert.Equal(t, cv.Convert("user_\u0131d-\u017fsl"), stringcase.Convert(
+			"user_\u0131d-\u017fsl", stringcase.CasePascal, opts))
+		assert.Equal(t, cv.Convert("IOS_K8S-\u212a8s"), stringcase.Convert(
+			"IOS_K8S-\u212a8s", stringcase.CasePascal, opts))
+	})
+
+	t.Run("return the input for unknown cases", func(t *testing.T) {
+		cv := stringcase.NewConverter(stringcase.CaseUnknown, stringcase.Options{})
+		assert.Equal(t, cv.Convert("fooBar"), "fooBar")
+		assert.Equal(t, string(cv.Append([]byte("x:"), "fooBar")), "x:fooBar")
+	})
+
+	t.Run("append to a buffer", func(t *testing.T) {
+		opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+		cv := stringcase.NewConverter(stringcase.CaseSnake, opts)
+		buf := make([]byte, 0, 64)
+		buf = cv.Append(buf, "fooBar100%baz")
+		buf = append(buf, ' ')
+		buf = cv.Append(buf, "quxQuux")
+		assert.Equal(t, string(buf), "foo_bar100%_baz qux_quux")
+
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = cv.Append(buf[:0], "fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+
+	t.Run("convert with a single allocation", func(t *testing.T) {
+		opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+		cv := stringcase.NewConverter(stringcase.CaseSnake, opts)
+		allocs := testing.AllocsPerRun(100, func() {
+			cv.Convert("fooBar100%baz-quxQuux-fooBar100%baz-quxQuux")
+		})
+		assert.Equal(t, allocs, 1.0)
+
+		input := strings.Repeat("fooBar", 50)
+		assert.Equal(t, cv.Convert(input), stringcase.SnakeCaseWithOptions(input, opts))
+	})
+
+	t.Run("same results as Convert", func(t *testing.T) {
+		optsList := []stringcase.Options{
+			{SeparateAfterNonAlphabets: true},
+			{SeparateAfterNonAlphabets: true, Keep: "#"},
+			{SeparateBeforeNonAlphabets: true, Keep: "#\u00e9\u2103"},
+			{SeparateAfterNonAlphabets: true, Separators: "-"},
+			{SeparateBeforeNonAlphabets: true, Separators: "-\u00e9\u2103", Unicode: true},
+			{SeparateAfterNonAlphabets: true, Acronyms: stringcase.NewWordList("AB", "xyz", "\u00e9a")},
+			{
+				SeparateAfterNonAlphabets: true,
+				Acronyms:                  stringcase.NewWordList("ab", "Y"),
+				AcronymStyle:              stringcase.AcronymStyleUpper,
+				ProtectedWords:            stringcase.NewWordList("aB1", "Xy", "XyZ", "\u00c9b", ""),
+				Unicode:                   true,
+			},
+			{SeparateAfterNonAlphabets: true, TitleStyle: stringcase.TitleStyleAP},
+			{SeparateAfterNonAlphabets: true, MinorWords: stringcase.NewWordList("b", "XY")},
+		}
+		corpus := generateCorpus()
+		for _, opts := range optsList {
+			for c := stringcase.CaseAda; c <= stringcase.CaseUpperSpace; c++ {
+				cv := stringcase.NewConverter(c, opts)
+				for _, input := range corpus[:2000] {
+					if string(cv.Append([]byte("x"), input)) != "x"+cv.Convert(input) {
+						assert.Equal(t, string(cv.Append([]byte("x"), input)), "x"+cv.Convert(input))
+						return
+					}
+					if cv.Convert(input) != stringcase.Convert(input, c, opts) {
+						assert.Equal(t, cv.Convert(input), stringcase.Convert(input, c, opts), input)
+						return
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("convert concurrently", func(t *testing.T) {
+		opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+		cv := stringcase.NewConverter(stringcase.CaseMacro, opts)
+		var wg sync.WaitGroup
+		results := make([]string, 8)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					results[i] = cv.Convert("foo-bar100%baz")
+				}
+			}(i)
+		}
+		wg.Wait()
+		for _, result := range results {
+			assert.Equal(t, result, "FOO_BAR100%_BAZ")
+		}
+	})
+}
+
+// generateCorpus returns all the strings up to four characters long over a small alphabet, and
+// random strings over a larger alphabet, which include the characters of all the kinds.
+func generateCorpus() []string {
+	corpus := []string{""}
+	small := []string{"a", "B", "1", "-", "#", "é"}
+	last := []string{""}
+	for n := 0; n < 4; n++ {
+		next := make([]string, 0, len(last)*len(small))
+		for _, s := range last {
+			for _, c := range small {
+				next = append(next, s+c)
+			}
+		}
+		corpus = append(corpus, next...)
+		last = next
+	}
+
+	large := []rune("abxyzABXYZ0129-_ .#%$éΩß日😀")
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		s := make([]rune, rnd.Intn(16))
+		for j := range s {
+			s[j] = large[rnd.Intn(len(large))]
+		}
+		corpus = append(corpus, string(s))
+	}
+	return corpus
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// Detection is the result of DetectCase.
+//
+// Cases is the case styles which the string is written in, in the order of the Case constants.
+// Ambiguous is true when there are more than one of them. Confidence is the probability that any
+// one of Cases is the case style the string was actually written in, assuming all of them are
+// equally likely, so it is 1 for a single case, 1/len(Cases) for ambiguous ones, and 0 when no
+// case is detected.
+type Detection struct {
+	Cases      []Case
+	Ambiguous  bool
+	Confidence float64
+}
+
+// Has reports whether c is one of the detected cases.
+func (d Detection) Has(c Case) bool {
+	for _, x := range d.Cases {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectCase finds the case styles which the input string is written in.
+//
+// A string is written in a case style when the conversion to it with the 〜Case function, which
+// does not take Options, returns the string unchanged. So "foo" is detected as snake_case,
+// kebab-case, camelCase, flatcase, and the other lowercase styles at once, and the result is
+// ambiguous, while "fooBar" is detected only as camelCase. An empty string is detected as none of
+// the case styles, since it has no words to tell its case style, although the Is〜Case functions
+// report it is written in any of them.
+func DetectCase(input string) Detection {
+	var d Detection
+	if len(input) == 0 {
+		return d
+	}
+	for c := CaseUnknown + 1; c < numCases; c++ {
+		if caseDefs[c].convert(input, defaultOptions) == input {
+			d.Cases = append(d.Cases, c)
+		}
+	}
+	d.Ambiguous = len(d.Cases) > 1
+	if len(d.Cases) > 0 {
+		d.Confidence = 1 / float64(len(d.Cases))
+	}
+	return d
+}
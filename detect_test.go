@@ -0,0 +1,118 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestDetectCase(t *testing.T) {
+	t.Run("detect a single case", func(t *testing.T) {
+		inputs := map[string]stringcase.Case{
+			"user_Account_Settings": stringcase.CaseCamelSnake,
+			"User_Account_Settings": stringcase.CaseAda,
+			"userAccountSettings":   stringcase.CaseCamel,
+			"USER-ACCOUNT-SETTINGS": stringcase.CaseCobol,
+			"user.account.settings": stringcase.CaseDot,
+			"user-account-settings": stringcase.CaseKebab,
+			"user account settings": stringcase.CaseLowerSpace,
+			"USER_ACCOUNT_SETTINGS": stringcase.CaseMacro,
+			"UserAccountSettings":   stringcase.CasePascal,
+			"user/account/settings": stringcase.CasePath,
+			"User account settings": stringcase.CaseSentence,
+			"user_account_settings": stringcase.CaseSnake,
+			"User Account Settings": stringcase.CaseTitle,
+			"User-Account-Settings": stringcase.CaseTrain,
+			"USER ACCOUNT SETTINGS": stringcase.CaseUpperSpace,
+		}
+		for input, c := range inputs {
+			d := stringcase.DetectCase(input)
+			assert.Equal(t, d.Cases, []stringcase.Case{c}, input)
+			assert.False(t, d.Ambiguous, input)
+			assert.Equal(t, d.Confidence, 1.0, input)
+			assert.True(t, d.Has(c), input)
+		}
+	})
+
+	t.Run("detect ambiguous cases of a lowercase word", func(t *testing.T) {
+		d := stringcase.DetectCase("user")
+		assert.Equal(t, d.Cases, []stringcase.Case{
+			stringcase.CaseCamel,
+			stringcase.CaseCamelSnake,
+			stringcase.CaseDot,
+			stringcase.CaseFlat,
+			stringcase.CaseKebab,
+			stringcase.CaseLowerSpace,
+			stringcase.CasePath,
+			stringcase.CaseSnake,
+		})
+		assert.True(t, d.Ambiguous)
+		assert.Equal(t, d.Confidence, 0.125)
+		assert.True(t, d.Has(stringcase.CaseFlat))
+		assert.False(t, d.Has(stringcase.CaseMacro))
+	})
+
+	t.Run("detect ambiguous cases of an uppercase word", func(t *testing.T) {
+		d := stringcase.DetectCase("USER")
+		assert.Equal(t, d.Cases, []stringcase.Case{
+			stringcase.CaseCobol,
+			stringcase.CaseMacro,
+			stringcase.CaseUpperFlat,
+			stringcase.CaseUpperSpace,
+		})
+		assert.True(t, d.Ambiguous)
+		assert.Equal(t, d.Confidence, 0.25)
+	})
+
+	t.Run("detect ambiguous cases of a capitalized word", func(t *testing.T) {
+		d := stringcase.DetectCase("User")
+		assert.Equal(t, d.Cases, []stringcase.Case{
+			stringcase.CaseAda,
+			stringcase.CasePascal,
+			stringcase.CaseSentence,
+			stringcase.CaseTitle,
+			stringcase.CaseTrain,
+		})
+		assert.True(t, d.Ambiguous)
+		assert.Equal(t, d.Confidence, 0.2)
+	})
+
+	t.Run("detect flatcase and UPPERFLATCASE", func(t *testing.T) {
+		d := stringcase.DetectCase("useraccount")
+		assert.True(t, d.Has(stringcase.CaseFlat))
+		assert.True(t, d.Ambiguous)
+
+		d = stringcase.DetectCase("USERACCOUNT")
+		assert.True(t, d.Has(stringcase.CaseUpperFlat))
+		assert.True(t, d.Ambiguous)
+	})
+
+	t.Run("detect cases with digits", func(t *testing.T) {
+		d := stringcase.DetectCase("user_id2")
+		assert.Equal(t, d.Cases, []stringcase.Case{stringcase.CaseSnake})
+
+		d = stringcase.DetectCase("2024")
+		assert.Equal(t, len(d.Cases), 17)
+		assert.True(t, d.Ambiguous)
+	})
+
+	t.Run("detect no cases of an empty string", func(t *testing.T) {
+		d := stringcase.DetectCase("")
+		assert.Nil(t, d.Cases)
+		assert.False(t, d.Ambiguous)
+		assert.Equal(t, d.Confidence, 0.0)
+		assert.True(t, stringcase.IsSnakeCase(""))
+	})
+
+	t.Run("detect no cases", func(t *testing.T) {
+		for _, input := range []string{"-", "user__account", "_user", "userACCOUNT", "User_account"} {
+			d := stringcase.DetectCase(input)
+			assert.Equal(t, len(d.Cases), 0, input)
+			assert.False(t, d.Ambiguous, input)
+			assert.Equal(t, d.Confidence, 0.0, input)
+			assert.False(t, d.Has(stringcase.CaseUnknown), input)
+		}
+	})
+}
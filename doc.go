@@ -4,23 +4,74 @@
 
 /*
 This library provides some functions that convert string cases between Ada_Case, camelCase,
-COBOL-CASE, kebab-case, MACRO_CASE, PascalCase, snake_case, Title Case, and Train-Case.
+camel_Snake_Case, COBOL-CASE, dot.case, flatcase, kebab-case, lower space case, MACRO_CASE,
+PascalCase, path/case, Sentence case, snake_case, Title Case, Train-Case, UPPERFLATCASE, and
+UPPER SPACE CASE.
 In addition, the functions Capitalize, Lowerize, and Upperize are provided to convert
 string cases with a custom joiner character.
+For other case styles, describe the casing of words, the joiner string, the prefix, and the
+suffix with the Style struct and use the Format function. The Style〜 functions return the
+predefined styles of the above cases.
+And the function Words splits a string into words at the same word boundaries as these
+functions find, and Tokenize and Scanner report the pieces of a string with their kinds and
+byte offsets.
+The 〜WithMapping functions also return the spans which map each byte range of the result to
+the byte range of the input string it came from.
+The Append〜 functions, such as AppendSnakeCase and AppendFormat, append the result to a
+byte slice like strconv.AppendInt, and do not allocate memory when the slice has enough capacity.
+To choose the case at run time, such as from a configuration file, use the function Convert
+with a Case value, which can be parsed from names like "snake_case" and "SCREAMING_SNAKE" with
+ParseCase and read from JSON and other text formats.
+The function DetectCase finds the cases which a string is written in, such as CaseSnake
+and CaseCamel, and reports whether the result is ambiguous, like "foo", which is written in
+both snake_case and camelCase, with the confidence of the result.
+The Is〜Case and Is〜CaseWithOptions functions report whether a string is already in a case,
+and CheckCase also tells the first offending position and the reason, such as
+"uppercase letter at byte 4".
 
 Essentially, these functions only target ASCII uppercase and lowercase letters for capitalization.
 All characters other than ASCII uppercase and lowercase letters and ASCII numbers are removed as
 word separators.
+If you want to convert letters of other scripts, such as Latin-1, Latin Extended, Greek, and
+Cyrillic, set the Unicode field of Options struct to true and use the 〜CaseWithOptions function.
+Then letters and decimal digits are classified and case-mapped by their Unicode categories.
+In addition, to keep letters without case, such as Han and Kana, in words and to separate words
+where the script changes, like "api-キー" from "APIキー", set the SeparateScripts field to true.
+To convert strings which look identical but are differently encoded, such as the ones in NFC and
+NFD, to the same result, set the Normalization field of Options struct to a normalization form.
+To get ASCII-only results from accented Latin letters, such as "creme_brulee" from
+"Crème Brûlée", set the FoldToASCII field of Options struct to true.
 
 If you want to use some symbols as separators, specify those symbols in the Separators field of
 Options struct and use the 〜CaseWithOptions function for the desired case.
 If you want to retain certain symbols and use everything else as separators, specify those symbols
 in Keep field of Options struct and use the 〜CaseWithOptions function for the desired case.
+To convert many strings with the same options, create a Converter with NewConverter, which
+precompiles the symbols in these fields once and can be shared by multiple goroutines. Its
+Append method appends the result to a byte slice like the Append〜 functions.
+
+If you want to write acronyms such as ID and URL in all caps in PascalCase, camelCase, and the other
+capitalized cases, like "UserID" from "user_id", specify them in the Acronyms field of Options
+struct as a WordList created by NewWordList. GoInitialisms returns a preset of the acronyms for Go
+identifiers.
+To capitalize them instead, or to keep the words in all caps in the input string, set the
+AcronymStyle field.
+And to keep the plurals of acronyms, such as "IDs" and "URLs", as single words, set the
+AcronymPlurals field to true.
+To keep words with mixed case or digits, such as "iOS" and "OAuth2", from being split and
+to write them in their canonical spellings in the capitalized cases, specify them in the
+ProtectedWords field.
+To write titles following a style guide, such as "The Lord of the Rings" in Chicago style,
+set the TitleStyle field to one of the TitleStyle〜 constants and use TitleCaseWithOptions.
+The minor words of the style can be extended with the MinorWords field.
 
 Additionally, you can specify whether to place word boundaries before and/or after non-alphabetic
 characters with conversion options.
 This can be set using the SeparateBeforeNonAlphabets and SeparateAfterNonAlphabets fields in the
 Options struct.
+To control each kind of word boundaries, such as lower-to-upper, letter-to-digit, and
+digit-to-letter, individually, set the Boundaries field to a combination of the Boundary〜
+constants.
 
 The 〜Case functions that do not take Options as an argument only place word boundaries after
 non-alphabetic characters.
@@ -28,3 +79,5 @@ In other words, they behave as if
 SeparateBeforeNonAlphabets = false and SeparateAfterNonAlphabets = true.
 */
 package stringcase
+
+//go:generate go run -C internal/gen . ../..
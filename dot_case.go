@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// DotCaseWithOptions converts the input string to dot case with the
+// specified options.
+func DotCaseWithOptions(input string, opts Options) string {
+	return Format(input, styleDot, opts)
+}
+
+// DotCaseWithMapping converts the input string to dot case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func DotCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleDot, opts)
+}
+
+// DotCase converts the input string to dot case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func DotCase(input string) string {
+	return Format(input, styleDot, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendDotCase appends the input string converted to dot case to dst
+// and returns the extended buffer, in the same way as DotCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendDotCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleDot, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsDotCaseWithOptions reports whether the input string is in dot case
+// with the specified options, that is, whether DotCaseWithOptions
+// returns it unchanged.
+func IsDotCaseWithOptions(input string, opts Options) bool {
+	return DotCaseWithOptions(input, opts) == input
+}
+
+// IsDotCase reports whether the input string is in dot case.
+func IsDotCase(input string) bool {
+	return DotCase(input) == input
+}
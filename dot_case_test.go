@@ -0,0 +1,1343 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestDotCase(t *testing.T) {
+	t.Run("convert camelCase", func(t *testing.T) {
+		result := stringcase.DotCase("abcDefGHIjk")
+		assert.Equal(t, result, "abc.def.gh.ijk")
+	})
+
+	t.Run("convert PascalCase", func(t *testing.T) {
+		result := stringcase.DotCase("AbcDefGHIjk")
+		assert.Equal(t, result, "abc.def.gh.ijk")
+	})
+
+	t.Run("convert snake_case", func(t *testing.T) {
+		result := stringcase.DotCase("abc_def_ghi")
+		assert.Equal(t, result, "abc.def.ghi")
+	})
+
+	t.Run("convert kebab-case", func(t *testing.T) {
+		result := stringcase.DotCase("abc-def-ghi")
+		assert.Equal(t, result, "abc.def.ghi")
+	})
+
+	t.Run("convert Train-Case", func(t *testing.T) {
+		result := stringcase.DotCase("Abc-Def-Ghi")
+		assert.Equal(t, result, "abc.def.ghi")
+	})
+
+	t.Run("convert MACRO_CASE", func(t *testing.T) {
+		result := stringcase.DotCase("ABC_DEF_GHI")
+		assert.Equal(t, result, "abc.def.ghi")
+	})
+
+	t.Run("convert COBOL-CASE", func(t *testing.T) {
+		result := stringcase.DotCase("ABC-DEF-GHI")
+		assert.Equal(t, result, "abc.def.ghi")
+	})
+
+	t.Run("convert with keeping digits", func(t *testing.T) {
+		result := stringcase.DotCase("abc123-456defG89HIJklMN12")
+		assert.Equal(t, result, "abc123.456.def.g89.hi.jkl.mn12")
+	})
+
+	t.Run("convert with symbols as seperators", func(t *testing.T) {
+		result := stringcase.DotCase(":.abc~!@def#$ghi%&jk(lm)no/?")
+		assert.Equal(t, result, "abc.def.ghi.jk.lm.no")
+	})
+
+	t.Run("convert when starting with digit", func(t *testing.T) {
+		result := stringcase.DotCase("123abc456def")
+		assert.Equal(t, result, "123.abc456.def")
+
+		result = stringcase.DotCase("123ABC456DEF")
+		assert.Equal(t, result, "123.abc456.def")
+
+		result = stringcase.DotCase("123Abc456Def")
+		assert.Equal(t, result, "123.abc456.def")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.DotCase("")
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestDotCaseWithOptions(t *testing.T) {
+	t.Run("non-alphabets as head of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123.456def.g.89hi.jkl.mn.12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc.def.ghi.jk.lm.no")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc.456def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc.456def")
+
+			result = stringcase.DotCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123.456.def.g89.hi.jkl.mn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc.def.ghi.jk.lm.no")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123.abc456.def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123.abc456.def")
+
+			result = stringcase.DotCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123.abc456.def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123.456.def.g.89.hi.jkl.mn.12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc.def.ghi.jk.lm.no")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+
+			result = stringcase.DotCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123.456def.g89hi.jkl.mn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abc.def.ghi.jk.lm.no")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.DotCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123.abc456.def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc._def._ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.-def.-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc._def._ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.-def.-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123.456def.g.89hi.jkl.mn.12")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123-456def.g.89hi.jkl.mn.12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc.~!.def.#.ghi.%.jk.lm.no.?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc.456def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc.456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.DotCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc.123def")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_.def_.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_.def_.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123.456.def.g89.hi.jkl.mn12")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456.def.g89.hi.jkl.mn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "..abc~!.def#.ghi%.jk.lm.no.?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123.abc456.def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123.abc456.def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.DotCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123.def")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc._.def._.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc._.def._.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123.456.def.g.89.hi.jkl.mn.12")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123-456.def.g.89.hi.jkl.mn.12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "..abc.~!.def.#.ghi.%.jk.lm.no.?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.DotCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc.123.def")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "-"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123.456def.g89hi.jkl.mn12")
+
+			opts.Separators = "_"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456def.g89hi.jkl.mn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!.def#.ghi%.jk.lm.no.?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.DotCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123def")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc._def._ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.-def.-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc._def._ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.-def.-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123.456def.g.89hi.jkl.mn.12")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123-456def.g.89hi.jkl.mn.12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc.456def")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc.456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc.~!.def.#.ghi.%.jk.lm.no.?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_.def_.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_.def_.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123.456.def.g89.hi.jkl.mn12")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456.def.g89.hi.jkl.mn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123.abc456.def")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123.abc456.def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "..abc~!.def#.ghi%.jk.lm.no.?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc._.def._.ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc._.def._.ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.-.def.-.ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123.456.def.g.89.hi.jkl.mn.12")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc.123-456.def.g.89.hi.jkl.mn.12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123.abc.456.def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "..abc.~!.def.#.ghi.%.jk.lm.no.?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abc.def.gh.ijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-.def-.ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "_"
+			result = stringcase.DotCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc.def.ghi")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123.456def.g89hi.jkl.mn12")
+
+			opts.Keep = "-"
+			result = stringcase.DotCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456def.g89hi.jkl.mn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.DotCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.DotCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.DotCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!.def#.ghi%.jk.lm.no.?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.DotCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+}
+
+func TestDotCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.DotCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "a.b.c1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.DotCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsDotCase(t *testing.T) {
+	t.Run("string in dot case", func(t *testing.T) {
+		assert.True(t, stringcase.IsDotCase("user.account.settings"))
+		assert.True(t, stringcase.IsDotCase(""))
+	})
+
+	t.Run("string not in dot case", func(t *testing.T) {
+		assert.False(t, stringcase.IsDotCase("user_account_settings"))
+		assert.False(t, stringcase.IsDotCase("user.account.settings_"))
+		assert.False(t, stringcase.IsDotCase("rate%.limit"))
+	})
+}
+
+func TestIsDotCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in dot case", func(t *testing.T) {
+		assert.True(t, stringcase.IsDotCaseWithOptions("rate%.limit", opts))
+		assert.True(t, stringcase.IsDotCaseWithOptions("user.account.settings", opts))
+	})
+
+	t.Run("string not in dot case", func(t *testing.T) {
+		assert.False(t, stringcase.IsDotCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsDotCaseWithOptions("user.account.settings_", opts))
+	})
+}
+
+func TestAppendDotCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendDotCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=user.account.settings")
+
+		buf = stringcase.AppendDotCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "user.account.settings")
+	})
+
+	t.Run("same result as DotCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendDotCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.DotCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendDotCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
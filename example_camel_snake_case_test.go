@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleCamelSnakeCase() {
+	camelSnake := stringcase.CamelSnakeCase("fooBarBaz")
+	fmt.Printf("(1) camelSnake = %s\n", camelSnake)
+
+	camelSnake = stringcase.CamelSnakeCase("foo-Bar100baz")
+	fmt.Printf("(2) camelSnake = %s\n", camelSnake)
+	// Output:
+	// (1) camelSnake = foo_Bar_Baz
+	// (2) camelSnake = foo_Bar100_Baz
+}
+
+func ExampleCamelSnakeCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	camelSnake := stringcase.CamelSnakeCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) camelSnake = %s\n\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) camelSnake = %s\n\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) camelSnake = %s\n", camelSnake)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	camelSnake = stringcase.CamelSnakeCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) camelSnake = %s\n", camelSnake)
+	// Output:
+	// (1) camelSnake = foo_Bar100_Baz
+	// (2) camelSnake = foo_Bar_100_Baz
+	// (3) camelSnake = foo_Bar_100baz
+	// (4) camelSnake = foo_Bar100baz
+	//
+	// (5) camelSnake = foo_Bar100%_Baz
+	// (6) camelSnake = foo_Bar_100%_Baz
+	// (7) camelSnake = foo_Bar_100%baz
+	// (8) camelSnake = foo_Bar100%baz
+	//
+	// (9) camelSnake = foo_Bar100%_Baz
+	// (a) camelSnake = foo_Bar_100%_Baz
+	// (b) camelSnake = foo_Bar_100%baz
+	// (c) camelSnake = foo_Bar100%baz
+}
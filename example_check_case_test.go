@@ -0,0 +1,23 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleCheckCase() {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+	err := stringcase.CheckCase("user_name", stringcase.CaseSnake, opts)
+	fmt.Printf("(1) err = %v\n", err)
+
+	err = stringcase.CheckCase("user_Name", stringcase.CaseSnake, opts)
+	fmt.Printf("(2) err = %v\n", err)
+
+	err = stringcase.CheckCase("user__name", stringcase.CaseSnake, opts)
+	fmt.Printf("(3) err = %v\n", err)
+	// Output:
+	// (1) err = <nil>
+	// (2) err = "user_Name" is not snake_case: uppercase letter at byte 5
+	// (3) err = "user__name" is not snake_case: double joiner at byte 5
+}
@@ -0,0 +1,33 @@
+package stringcase_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleConvert() {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+	result := stringcase.Convert("fooBar100Baz", stringcase.CaseSnake, opts)
+	fmt.Printf("(1) result = %s\n", result)
+
+	to, _ := stringcase.ParseCase("SCREAMING-KEBAB")
+	result = stringcase.Convert("fooBar100Baz", to, opts)
+	fmt.Printf("(2) result = %s\n", result)
+	// Output:
+	// (1) result = foo_bar100_baz
+	// (2) result = FOO-BAR100-BAZ
+}
+
+func ExampleCase_UnmarshalText() {
+	var config struct {
+		Columns stringcase.Case `json:"columns"`
+	}
+	_ = json.Unmarshal([]byte(`{"columns": "snake"}`), &config)
+	fmt.Printf("columns = %v\n", config.Columns)
+	fmt.Printf("result = %s\n", stringcase.Convert("userId", config.Columns, stringcase.Options{}))
+	// Output:
+	// columns = snake_case
+	// result = user_id
+}
@@ -0,0 +1,32 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleNewConverter() {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateAfterNonAlphabets: true,
+		Keep:                      "%",
+	})
+	fmt.Printf("(1) result = %s\n", cv.Convert("fooBar100%baz"))
+	fmt.Printf("(2) result = %s\n", cv.Convert("rate%Limit"))
+	// Output:
+	// (1) result = foo_bar100%_baz
+	// (2) result = rate%_limit
+}
+
+func ExampleConverter_Append() {
+	cv := stringcase.NewConverter(stringcase.CaseSnake, stringcase.Options{
+		SeparateAfterNonAlphabets: true,
+		Keep:                      "%",
+	})
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "column: "...)
+	buf = cv.Append(buf, "fooBar100%baz")
+	fmt.Printf("%s\n", buf)
+	// Output:
+	// column: foo_bar100%_baz
+}
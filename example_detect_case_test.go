@@ -0,0 +1,25 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleDetectCase() {
+	d := stringcase.DetectCase("userAccount")
+	fmt.Printf("(1) cases = %v, ambiguous = %t\n", d.Cases, d.Ambiguous)
+
+	d = stringcase.DetectCase("USER")
+	fmt.Printf("(2) cases = %v, ambiguous = %t\n", d.Cases, d.Ambiguous)
+
+	fmt.Printf("(3) confidence = %.2f\n", d.Confidence)
+
+	d = stringcase.DetectCase("user__account")
+	fmt.Printf("(4) cases = %v, ambiguous = %t\n", d.Cases, d.Ambiguous)
+	// Output:
+	// (1) cases = [camelCase], ambiguous = false
+	// (2) cases = [COBOL-CASE MACRO_CASE UPPERFLATCASE UPPER SPACE CASE], ambiguous = true
+	// (3) confidence = 0.25
+	// (4) cases = [], ambiguous = false
+}
@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleDotCase() {
+	dot := stringcase.DotCase("fooBarBaz")
+	fmt.Printf("(1) dot = %s\n", dot)
+
+	dot = stringcase.DotCase("foo-Bar100baz")
+	fmt.Printf("(2) dot = %s\n", dot)
+	// Output:
+	// (1) dot = foo.bar.baz
+	// (2) dot = foo.bar100.baz
+}
+
+func ExampleDotCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	dot := stringcase.DotCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) dot = %s\n\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) dot = %s\n\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) dot = %s\n", dot)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	dot = stringcase.DotCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) dot = %s\n", dot)
+	// Output:
+	// (1) dot = foo.bar100.baz
+	// (2) dot = foo.bar.100.baz
+	// (3) dot = foo.bar.100baz
+	// (4) dot = foo.bar100baz
+	//
+	// (5) dot = foo.bar100%.baz
+	// (6) dot = foo.bar.100%.baz
+	// (7) dot = foo.bar.100%baz
+	// (8) dot = foo.bar100%baz
+	//
+	// (9) dot = foo.bar100%.baz
+	// (a) dot = foo.bar.100%.baz
+	// (b) dot = foo.bar.100%baz
+	// (c) dot = foo.bar100%baz
+}
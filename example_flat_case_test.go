@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleFlatCase() {
+	flat := stringcase.FlatCase("fooBarBaz")
+	fmt.Printf("(1) flat = %s\n", flat)
+
+	flat = stringcase.FlatCase("foo-Bar100baz")
+	fmt.Printf("(2) flat = %s\n", flat)
+	// Output:
+	// (1) flat = foobarbaz
+	// (2) flat = foobar100baz
+}
+
+func ExampleFlatCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	flat := stringcase.FlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) flat = %s\n\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) flat = %s\n\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) flat = %s\n", flat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	flat = stringcase.FlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) flat = %s\n", flat)
+	// Output:
+	// (1) flat = foobar100baz
+	// (2) flat = foobar100baz
+	// (3) flat = foobar100baz
+	// (4) flat = foobar100baz
+	//
+	// (5) flat = foobar100%baz
+	// (6) flat = foobar100%baz
+	// (7) flat = foobar100%baz
+	// (8) flat = foobar100%baz
+	//
+	// (9) flat = foobar100%baz
+	// (a) flat = foobar100%baz
+	// (b) flat = foobar100%baz
+	// (c) flat = foobar100%baz
+}
@@ -0,0 +1,37 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleFormat() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+
+	style := stringcase.Style{
+		First:  stringcase.WordCaseLower,
+		Rest:   stringcase.WordCaseTitle,
+		Joiner: ".",
+	}
+	result := stringcase.Format("foo_bar_baz", style, opts)
+	fmt.Printf("(1) result = %s\n", result)
+
+	style = stringcase.Style{
+		First:  stringcase.WordCaseTitle,
+		Rest:   stringcase.WordCaseTitle,
+		Joiner: "::",
+	}
+	result = stringcase.Format("std-io-reader", style, opts)
+	fmt.Printf("(2) result = %s\n", result)
+
+	style = stringcase.StyleSnake()
+	style.Prefix = "__"
+	style.Suffix = "__"
+	result = stringcase.Format("Init", style, opts)
+	fmt.Printf("(3) result = %s\n", result)
+	// Output:
+	// (1) result = foo.Bar.Baz
+	// (2) result = Std::Io::Reader
+	// (3) result = __init__
+}
@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleLowerSpaceCase() {
+	lowerSpace := stringcase.LowerSpaceCase("fooBarBaz")
+	fmt.Printf("(1) lowerSpace = %s\n", lowerSpace)
+
+	lowerSpace = stringcase.LowerSpaceCase("foo-Bar100baz")
+	fmt.Printf("(2) lowerSpace = %s\n", lowerSpace)
+	// Output:
+	// (1) lowerSpace = foo bar baz
+	// (2) lowerSpace = foo bar100 baz
+}
+
+func ExampleLowerSpaceCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	lowerSpace := stringcase.LowerSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) lowerSpace = %s\n\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) lowerSpace = %s\n\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) lowerSpace = %s\n", lowerSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	lowerSpace = stringcase.LowerSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) lowerSpace = %s\n", lowerSpace)
+	// Output:
+	// (1) lowerSpace = foo bar100 baz
+	// (2) lowerSpace = foo bar 100 baz
+	// (3) lowerSpace = foo bar 100baz
+	// (4) lowerSpace = foo bar100baz
+	//
+	// (5) lowerSpace = foo bar100% baz
+	// (6) lowerSpace = foo bar 100% baz
+	// (7) lowerSpace = foo bar 100%baz
+	// (8) lowerSpace = foo bar100%baz
+	//
+	// (9) lowerSpace = foo bar100% baz
+	// (a) lowerSpace = foo bar 100% baz
+	// (b) lowerSpace = foo bar 100%baz
+	// (c) lowerSpace = foo bar100%baz
+}
@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExamplePathCase() {
+	path := stringcase.PathCase("fooBarBaz")
+	fmt.Printf("(1) path = %s\n", path)
+
+	path = stringcase.PathCase("foo-Bar100baz")
+	fmt.Printf("(2) path = %s\n", path)
+	// Output:
+	// (1) path = foo/bar/baz
+	// (2) path = foo/bar100/baz
+}
+
+func ExamplePathCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	path := stringcase.PathCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	path = stringcase.PathCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	path = stringcase.PathCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	path = stringcase.PathCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) path = %s\n\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) path = %s\n\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) path = %s\n", path)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	path = stringcase.PathCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) path = %s\n", path)
+	// Output:
+	// (1) path = foo/bar100/baz
+	// (2) path = foo/bar/100/baz
+	// (3) path = foo/bar/100baz
+	// (4) path = foo/bar100baz
+	//
+	// (5) path = foo/bar100%/baz
+	// (6) path = foo/bar/100%/baz
+	// (7) path = foo/bar/100%baz
+	// (8) path = foo/bar100%baz
+	//
+	// (9) path = foo/bar100%/baz
+	// (a) path = foo/bar/100%/baz
+	// (b) path = foo/bar/100%baz
+	// (c) path = foo/bar100%baz
+}
@@ -0,0 +1,31 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleSentenceCase() {
+	sentence := stringcase.SentenceCase("userAccountSettings")
+	fmt.Printf("(1) sentence = %s\n", sentence)
+
+	sentence = stringcase.SentenceCase("hello world. how are you?")
+	fmt.Printf("(2) sentence = %s\n", sentence)
+	// Output:
+	// (1) sentence = User account settings
+	// (2) sentence = Hello world. How are you?
+}
+
+func ExampleSentenceCaseWithOptions() {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Acronyms:                   stringcase.NewWordList("SSH"),
+		ProtectedWords:             stringcase.NewWordList("GitHub"),
+	}
+	sentence := stringcase.SentenceCaseWithOptions("connect_to_github_via_ssh", opts)
+	fmt.Printf("sentence = %s\n", sentence)
+	// Output:
+	// sentence = Connect to GitHub via SSH
+}
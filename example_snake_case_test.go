@@ -81,3 +81,41 @@ func ExampleSnakeCaseWithOptions() {
 	// (b) snake = foo_bar_100%baz
 	// (c) snake = foo_bar100%baz
 }
+
+func ExampleSnakeCaseWithMapping() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	input := "aB--c"
+	snake, spans := stringcase.SnakeCaseWithMapping(input, opts)
+	fmt.Printf("snake = %s\n", snake)
+	for _, span := range spans {
+		fmt.Printf("%q <- %q\n", snake[span.OutStart:span.OutEnd], input[span.InStart:span.InEnd])
+	}
+	// Output:
+	// snake = a_b_c
+	// "a" <- "a"
+	// "_" <- ""
+	// "b" <- "B"
+	// "_" <- "--"
+	// "c" <- "c"
+}
+
+func ExampleIsSnakeCase() {
+	fmt.Printf("(1) %t\n", stringcase.IsSnakeCase("foo_bar100_baz"))
+	fmt.Printf("(2) %t\n", stringcase.IsSnakeCase("fooBar100Baz"))
+
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+	fmt.Printf("(3) %t\n", stringcase.IsSnakeCaseWithOptions("foo_bar100%_baz", opts))
+	// Output:
+	// (1) true
+	// (2) false
+	// (3) true
+}
+
+func ExampleAppendSnakeCase() {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "column: "...)
+	buf = stringcase.AppendSnakeCase(buf, "fooBar100Baz")
+	fmt.Printf("%s\n", buf)
+	// Output:
+	// column: foo_bar100_baz
+}
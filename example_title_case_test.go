@@ -81,3 +81,25 @@ func ExampleTitleCaseWithOptions() {
 	// (b) title = Foo Bar 100%baz
 	// (c) title = Foo Bar100%baz
 }
+
+func ExampleTitleCaseWithOptions_titleStyle() {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, TitleStyle: stringcase.TitleStyleChicago}
+	title := stringcase.TitleCaseWithOptions("the lord of the rings", opts)
+	fmt.Printf("(1) title = %s\n", title)
+
+	title = stringcase.TitleCaseWithOptions("state-of-the-art design: a guide to living without fear", opts)
+	fmt.Printf("(2) title = %s\n", title)
+
+	opts.TitleStyle = stringcase.TitleStyleAP
+	title = stringcase.TitleCaseWithOptions("state-of-the-art design: a guide to living without fear", opts)
+	fmt.Printf("(3) title = %s\n", title)
+
+	opts.MinorWords = stringcase.NewWordList("vs")
+	title = stringcase.TitleCaseWithOptions("cats vs dogs", opts)
+	fmt.Printf("(4) title = %s\n", title)
+	// Output:
+	// (1) title = The Lord of the Rings
+	// (2) title = State-of-the-Art Design: A Guide to Living without Fear
+	// (3) title = State-of-the-Art Design: A Guide to Living Without Fear
+	// (4) title = Cats vs Dogs
+}
@@ -0,0 +1,40 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleTokenize() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	input := "fooBar100-baz"
+	for _, token := range stringcase.Tokenize(input, opts) {
+		fmt.Printf("%d %q [%d:%d] %t\n",
+			token.Kind, input[token.Start:token.End], token.Start, token.End, token.WordStart)
+	}
+	// Output:
+	// 0 "foo" [0:3] true
+	// 0 "Bar" [3:6] true
+	// 1 "100" [6:9] false
+	// 3 "-" [9:10] false
+	// 0 "baz" [10:13] true
+}
+
+func ExampleScanner() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	s := stringcase.NewScanner("HTTPServer_v2", opts)
+	for s.Scan() {
+		if s.Token().Kind == stringcase.TokenSeparator {
+			fmt.Printf("dropped %q\n", s.Text())
+		} else {
+			fmt.Printf("kept %q\n", s.Text())
+		}
+	}
+	// Output:
+	// kept "HTTP"
+	// kept "Server"
+	// dropped "_"
+	// kept "v"
+	// kept "2"
+}
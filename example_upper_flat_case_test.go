@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleUpperFlatCase() {
+	upperFlat := stringcase.UpperFlatCase("fooBarBaz")
+	fmt.Printf("(1) upperFlat = %s\n", upperFlat)
+
+	upperFlat = stringcase.UpperFlatCase("foo-Bar100baz")
+	fmt.Printf("(2) upperFlat = %s\n", upperFlat)
+	// Output:
+	// (1) upperFlat = FOOBARBAZ
+	// (2) upperFlat = FOOBAR100BAZ
+}
+
+func ExampleUpperFlatCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	upperFlat := stringcase.UpperFlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) upperFlat = %s\n\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) upperFlat = %s\n\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) upperFlat = %s\n", upperFlat)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	upperFlat = stringcase.UpperFlatCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) upperFlat = %s\n", upperFlat)
+	// Output:
+	// (1) upperFlat = FOOBAR100BAZ
+	// (2) upperFlat = FOOBAR100BAZ
+	// (3) upperFlat = FOOBAR100BAZ
+	// (4) upperFlat = FOOBAR100BAZ
+	//
+	// (5) upperFlat = FOOBAR100%BAZ
+	// (6) upperFlat = FOOBAR100%BAZ
+	// (7) upperFlat = FOOBAR100%BAZ
+	// (8) upperFlat = FOOBAR100%BAZ
+	//
+	// (9) upperFlat = FOOBAR100%BAZ
+	// (a) upperFlat = FOOBAR100%BAZ
+	// (b) upperFlat = FOOBAR100%BAZ
+	// (c) upperFlat = FOOBAR100%BAZ
+}
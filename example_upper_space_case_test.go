@@ -0,0 +1,83 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleUpperSpaceCase() {
+	upperSpace := stringcase.UpperSpaceCase("fooBarBaz")
+	fmt.Printf("(1) upperSpace = %s\n", upperSpace)
+
+	upperSpace = stringcase.UpperSpaceCase("foo-Bar100baz")
+	fmt.Printf("(2) upperSpace = %s\n", upperSpace)
+	// Output:
+	// (1) upperSpace = FOO BAR BAZ
+	// (2) upperSpace = FOO BAR100 BAZ
+}
+
+func ExampleUpperSpaceCaseWithOptions() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	upperSpace := stringcase.UpperSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(1) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(2) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(3) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100baz", opts)
+	fmt.Printf("(4) upperSpace = %s\n\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Separators: "#"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(5) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Separators: "#"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(6) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Separators: "#"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(7) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Separators: "#"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(8) upperSpace = %s\n\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true, Keep: "%"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(9) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true, Keep: "%"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(a) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: false, Keep: "%"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(b) upperSpace = %s\n", upperSpace)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: false, Keep: "%"}
+	upperSpace = stringcase.UpperSpaceCaseWithOptions("foo#Bar100%baz", opts)
+	fmt.Printf("(c) upperSpace = %s\n", upperSpace)
+	// Output:
+	// (1) upperSpace = FOO BAR100 BAZ
+	// (2) upperSpace = FOO BAR 100 BAZ
+	// (3) upperSpace = FOO BAR 100BAZ
+	// (4) upperSpace = FOO BAR100BAZ
+	//
+	// (5) upperSpace = FOO BAR100% BAZ
+	// (6) upperSpace = FOO BAR 100% BAZ
+	// (7) upperSpace = FOO BAR 100%BAZ
+	// (8) upperSpace = FOO BAR100%BAZ
+	//
+	// (9) upperSpace = FOO BAR100% BAZ
+	// (a) upperSpace = FOO BAR 100% BAZ
+	// (b) upperSpace = FOO BAR 100%BAZ
+	// (c) upperSpace = FOO BAR100%BAZ
+}
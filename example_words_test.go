@@ -0,0 +1,20 @@
+package stringcase_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/stringcase"
+)
+
+func ExampleWords() {
+	opts := stringcase.Options{SeparateBeforeNonAlphabets: false, SeparateAfterNonAlphabets: true}
+	words := stringcase.Words("fooBar100-baz", opts)
+	fmt.Printf("(1) words = %q\n", words)
+
+	opts = stringcase.Options{SeparateBeforeNonAlphabets: true, SeparateAfterNonAlphabets: true}
+	words = stringcase.Words("XMLHttpRequest2", opts)
+	fmt.Printf("(2) words = %q\n", words)
+	// Output:
+	// (1) words = ["foo" "Bar100" "baz"]
+	// (2) words = ["XML" "Http" "Request" "2"]
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// FlatCaseWithOptions converts the input string to flat case with the
+// specified options.
+func FlatCaseWithOptions(input string, opts Options) string {
+	return Format(input, styleFlat, opts)
+}
+
+// FlatCaseWithMapping converts the input string to flat case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func FlatCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleFlat, opts)
+}
+
+// FlatCase converts the input string to flat case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func FlatCase(input string) string {
+	return Format(input, styleFlat, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendFlatCase appends the input string converted to flat case to dst
+// and returns the extended buffer, in the same way as FlatCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendFlatCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleFlat, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsFlatCaseWithOptions reports whether the input string is in flat case
+// with the specified options, that is, whether FlatCaseWithOptions
+// returns it unchanged.
+func IsFlatCaseWithOptions(input string, opts Options) bool {
+	return FlatCaseWithOptions(input, opts) == input
+}
+
+// IsFlatCase reports whether the input string is in flat case.
+func IsFlatCase(input string) bool {
+	return FlatCase(input) == input
+}
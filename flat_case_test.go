@@ -0,0 +1,1343 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestFlatCase(t *testing.T) {
+	t.Run("convert camelCase", func(t *testing.T) {
+		result := stringcase.FlatCase("abcDefGHIjk")
+		assert.Equal(t, result, "abcdefghijk")
+	})
+
+	t.Run("convert PascalCase", func(t *testing.T) {
+		result := stringcase.FlatCase("AbcDefGHIjk")
+		assert.Equal(t, result, "abcdefghijk")
+	})
+
+	t.Run("convert snake_case", func(t *testing.T) {
+		result := stringcase.FlatCase("abc_def_ghi")
+		assert.Equal(t, result, "abcdefghi")
+	})
+
+	t.Run("convert kebab-case", func(t *testing.T) {
+		result := stringcase.FlatCase("abc-def-ghi")
+		assert.Equal(t, result, "abcdefghi")
+	})
+
+	t.Run("convert Train-Case", func(t *testing.T) {
+		result := stringcase.FlatCase("Abc-Def-Ghi")
+		assert.Equal(t, result, "abcdefghi")
+	})
+
+	t.Run("convert MACRO_CASE", func(t *testing.T) {
+		result := stringcase.FlatCase("ABC_DEF_GHI")
+		assert.Equal(t, result, "abcdefghi")
+	})
+
+	t.Run("convert COBOL-CASE", func(t *testing.T) {
+		result := stringcase.FlatCase("ABC-DEF-GHI")
+		assert.Equal(t, result, "abcdefghi")
+	})
+
+	t.Run("convert with keeping digits", func(t *testing.T) {
+		result := stringcase.FlatCase("abc123-456defG89HIJklMN12")
+		assert.Equal(t, result, "abc123456defg89hijklmn12")
+	})
+
+	t.Run("convert with symbols as seperators", func(t *testing.T) {
+		result := stringcase.FlatCase(":.abc~!@def#$ghi%&jk(lm)no/?")
+		assert.Equal(t, result, "abcdefghijklmno")
+	})
+
+	t.Run("convert when starting with digit", func(t *testing.T) {
+		result := stringcase.FlatCase("123abc456def")
+		assert.Equal(t, result, "123abc456def")
+
+		result = stringcase.FlatCase("123ABC456DEF")
+		assert.Equal(t, result, "123abc456def")
+
+		result = stringcase.FlatCase("123Abc456Def")
+		assert.Equal(t, result, "123abc456def")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.FlatCase("")
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestFlatCaseWithOptions(t *testing.T) {
+	t.Run("non-alphabets as head of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abcdefghijklmno")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abcdefghijklmno")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abcdefghijklmno")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "abcdefghijklmno")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.FlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123def")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.FlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123def")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.FlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123def")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "-"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Separators = "_"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.FlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "abc123def")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "abcdefghijk")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "_"
+			result = stringcase.FlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "abc_def_ghi")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abcdefghi")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "abc-def-ghi")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123456defg89hijklmn12")
+
+			opts.Keep = "-"
+			result = stringcase.FlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "abc123-456defg89hijklmn12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.FlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123abc456def")
+
+			result = stringcase.FlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123abc456def")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.FlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".abc~!def#ghi%jklmno?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.FlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+}
+
+func TestFlatCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.FlatCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "abc1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 1, InStart: 2, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 3},
+			{OutStart: 2, OutEnd: 2, InStart: 3, InEnd: 4},
+			{OutStart: 2, OutEnd: 3, InStart: 4, InEnd: 5},
+			{OutStart: 3, OutEnd: 4, InStart: 5, InEnd: 6},
+			{OutStart: 4, OutEnd: 4, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.FlatCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsFlatCase(t *testing.T) {
+	t.Run("string in flat case", func(t *testing.T) {
+		assert.True(t, stringcase.IsFlatCase("useraccountsettings"))
+		assert.True(t, stringcase.IsFlatCase(""))
+	})
+
+	t.Run("string not in flat case", func(t *testing.T) {
+		assert.False(t, stringcase.IsFlatCase("user_account_settings"))
+		assert.False(t, stringcase.IsFlatCase("useraccountsettings_"))
+		assert.False(t, stringcase.IsFlatCase("rate%limit"))
+	})
+}
+
+func TestIsFlatCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in flat case", func(t *testing.T) {
+		assert.True(t, stringcase.IsFlatCaseWithOptions("rate%limit", opts))
+		assert.True(t, stringcase.IsFlatCaseWithOptions("useraccountsettings", opts))
+	})
+
+	t.Run("string not in flat case", func(t *testing.T) {
+		assert.False(t, stringcase.IsFlatCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsFlatCaseWithOptions("useraccountsettings_", opts))
+	})
+}
+
+func TestAppendFlatCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendFlatCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=useraccountsettings")
+
+		buf = stringcase.AppendFlatCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "useraccountsettings")
+	})
+
+	t.Run("same result as FlatCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendFlatCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.FlatCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendFlatCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"unicode/utf8"
+)
+
+func (wc WordCase) mapping(isHeadOfWord bool) caseMapping {
+	switch wc {
+	case WordCaseUpper:
+		return mapToUpper
+	case WordCaseTitle:
+		if isHeadOfWord {
+			return mapToTitle
+		}
+	}
+	return mapToLower
+}
+
+// appendWords appends the words in the input string, which are found by wordScanner, to dst
+// with the joiner between them. The letters of the first word are cased with first, and those of
+// the other words with rest. A titlecased word is uppercased instead when Options.Acronyms and
+// Options.AcronymStyle specify it to be rendered in all caps, or is written in its spelling in
+// Options.ProtectedWords when it is one of them. If r is not nil, the spans which map the
+// appended bytes to the input string are recorded into it.
+func appendWords(
+	dst []byte, input string, opts *Options, first, rest WordCase, joiner string,
+	r *spanRecorder, rules proseRules,
+) []byte {
+	if r == nil && rules == nil && isPlainAscii(input, opts) {
+		return appendAsciiWords(dst, input, opts, first, rest, joiner)
+	}
+
+	s := newWordScanner(input, opts)
+	wc := first
+	cur := first
+	pluralSuffix := -1
+	isDutchIJ := false
+	isFirstWord := true
+	sepStart := -1
+	lastWordStart := -1
+	if rules != nil {
+		lastWordStart = s.lastWordStart()
+	}
+
+	for s.scan() {
+		if s.class == charIsSepMark {
+			if sepStart < 0 {
+				sepStart = s.start
+			}
+			r.add(len(dst), s.start, s.end)
+			continue
+		}
+		if rules != nil && (s.newWord || isFirstWord) {
+			end, _ := s.wordEnd()
+			seps := ""
+			if sepStart >= 0 && !isFirstWord {
+				seps = input[sepStart:s.start]
+			}
+			var punct, joiner string
+			punct, joiner, wc = rules.head(seps, input[s.start:end], input[end:], isFirstWord,
+				s.start == lastWordStart)
+			dst = append(dst, punct...)
+			dst = append(dst, joiner...)
+			r.join(len(dst), s.start)
+		} else if s.newWord {
+			dst = append(dst, joiner...)
+			r.join(len(dst), s.start)
+			wc = rest
+		}
+		sepStart = -1
+		isFirstWord = false
+		isHeadOfWord := s.isHead || s.newWord
+		if isHeadOfWord {
+			cur = wc
+			pluralSuffix = -1
+			keepsNames := wc == WordCaseTitle || rules != nil
+			if len(s.protected) > 0 {
+				// The first word of camelCase keeps the spelling which starts with a lowercase
+				// letter, like "macOS", but the other spellings are lowercased.
+				head, _ := utf8.DecodeRuneInString(s.protected)
+				if keepsNames || (wc == WordCaseLower && rest == WordCaseTitle &&
+					isLowerCase(head, opts.isUnicode())) {
+					dst = append(dst, s.protected...)
+				} else {
+					m := wc.mapping(false)
+					dst = appendProtectedWord(dst, input[s.start:s.end], m, opts)
+				}
+				r.add(len(dst), s.start, s.end)
+				isDutchIJ = false
+				continue
+			}
+			if keepsNames && opts.hasAcronymRules() {
+				end, isUpper := s.wordEnd()
+				word := input[s.start:end]
+				if opts.isAllCapsWord(word, isUpper) {
+					cur = WordCaseUpper
+				} else if stem, ok := pluralStem(word); ok && opts.isAllCapsWord(stem, true) {
+					cur = WordCaseUpper
+					pluralSuffix = end - 1
+				}
+			}
+		}
+
+		if (s.class == charIsUpper || s.class == charIsLower) && s.start != pluralSuffix {
+			m := cur.mapping(isHeadOfWord || (isDutchIJ && isDutchJ(s.ch)))
+			if s.end == s.start+1 && s.locale == LocaleNone {
+				switch {
+				case m == mapToLower:
+					dst = append(dst, byte(toLowerCase(s.ch)))
+				case s.class == charIsLower:
+					dst = append(dst, byte(toAsciiUpperCase(s.ch)))
+				default:
+					dst = append(dst, input[s.start])
+				}
+			} else if s.class == charIsLower && m == mapToLower {
+				dst = append(dst, input[s.start:s.end]...)
+			} else if s.ch == capitalSigma && m == mapToLower && !isHeadOfWord &&
+				(s.prevClass == charIsUpper || s.prevClass == charIsLower) &&
+				isFinalSigma(input, s.runStart, s.end, opts) {
+				dst = utf8.AppendRune(dst, finalSigma)
+				dst = appendCasedMarks(dst, s.ch, input[s.markPos:s.end], mapToLower, s.locale)
+			} else {
+				dst = appendCasedLetter(dst, s.ch, input[s.markPos:s.end], m, s.locale)
+			}
+			isDutchIJ = s.locale == LocaleDutch && isHeadOfWord && isDutchI(s.ch)
+		} else if s.end == s.start+1 {
+			dst = append(dst, input[s.start])
+			isDutchIJ = false
+		} else {
+			dst = append(dst, input[s.start:s.end]...)
+			isDutchIJ = false
+		}
+		r.add(len(dst), s.start, s.end)
+	}
+	if rules != nil && sepStart >= 0 && !isFirstWord {
+		dst = append(dst, rules.tail(input[sepStart:])...)
+		r.join(len(dst), len(input))
+	}
+
+	return dst
+}
+
+// isPlainAscii reports whether the input string can be converted by appendAsciiWords, that is,
+// it consists only of ASCII characters and the options have no rules about acronyms, protected
+// words, and locales. A CR is excluded when opts.Unicode is true, since CR LF is a grapheme
+// cluster.
+func isPlainAscii(input string, opts *Options) bool {
+	if len(opts.ProtectedWords.words()) > 0 || opts.hasAcronymRules() || opts.AcronymPlurals ||
+		opts.locale() != LocaleNone {
+		return false
+	}
+	for i := 0; i < len(input); i++ {
+		if input[i] >= utf8.RuneSelf || (input[i] == '\r' && opts.isUnicode()) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendAsciiWords works like appendWords for an input string which isPlainAscii accepts. Since
+// every unit is a byte and neither the scripts nor the lookahead for plural suffixes matter, it
+// decides the word boundaries with the same flag states as wordScanner in a single loop.
+func appendAsciiWords(
+	dst []byte, input string, opts *Options, first, rest WordCase, joiner string,
+) []byte {
+	flag := chIsFirstOfStr
+	lastClass := charIsUpper
+	wc := first
+
+	for i := 0; i < len(input); i++ {
+		ch := input[i]
+		class := charClassOf(rune(ch), opts)
+		isHead := flag == chIsFirstOfStr && class != charIsSepMark
+		newWord := false
+
+		switch class {
+		case charIsUpper:
+			if flag != chIsFirstOfStr && flag != chIsNextOfSepMark &&
+				!opts.isWordBoundary(lastClass, charIsUpper) {
+				if lastClass == charIsUpper || opts.Boundaries == 0 {
+					flag = chIsNextOfContdUpper
+					newWord = opts.separatesAcronyms() && i+1 < len(input) &&
+						isAsciiLowerCase(rune(input[i+1]))
+				} else {
+					flag = chIsNextOfUpper
+				}
+			} else {
+				newWord = flag != chIsFirstOfStr
+				flag = chIsNextOfUpper
+			}
+		case charIsLower:
+			newWord = flag == chIsNextOfSepMark ||
+				(flag != chIsFirstOfStr && opts.isWordBoundary(lastClass, charIsLower))
+			flag = chIsOther
+		case charIsDigit, charIsKeptMark:
+			newWord = flag == chIsNextOfSepMark ||
+				(flag != chIsFirstOfStr && opts.isWordBoundary(lastClass, class))
+			flag = chIsNextOfKeptMark
+		default:
+			if flag != chIsFirstOfStr {
+				flag = chIsNextOfSepMark
+			}
+			continue
+		}
+		lastClass = class
+
+		if newWord {
+			dst = append(dst, joiner...)
+			wc = rest
+		}
+		switch m := wc.mapping(isHead || newWord); {
+		case class == charIsLower && m != mapToLower:
+			ch = byte(toAsciiUpperCase(rune(ch)))
+		case class == charIsUpper && m == mapToLower:
+			ch = byte(toAsciiLowerCase(rune(ch)))
+		}
+		dst = append(dst, ch)
+	}
+
+	return dst
+}
+
+// proseRules decides how the words are written in the cases for prose, such as sentence case,
+// instead of the fixed casing and joiner. In these cases, the words which match Options.Acronyms
+// or Options.ProtectedWords are written in their styles even when they are not titlecased.
+type proseRules interface {
+	// head returns the punctuation kept from the separators and the joiner, which are written in
+	// this order before a word, and the casing of the word. seps is the separators between the
+	// word and the preceding word, and is always empty for the first word. rest is the input
+	// string following the word.
+	head(seps, word, rest string, isFirst, isLast bool) (string, string, WordCase)
+
+	// tail returns the string written after the last word, from the separators following it.
+	tail(seps string) string
+}
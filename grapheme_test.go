@@ -0,0 +1,147 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestGraphemeCluster(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Unicode:                    true,
+	}
+
+	t.Run("keep combining marks with base letters", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("cafe\u0301Bar", opts)
+		assert.Equal(t, result, "cafe\u0301_bar")
+
+		result = stringcase.PascalCaseWithOptions("cafe\u0301_bar", opts)
+		assert.Equal(t, result, "Cafe\u0301Bar")
+
+		result = stringcase.MacroCaseWithOptions("cafe\u0301Bar", opts)
+		assert.Equal(t, result, "CAFE\u0301_BAR")
+
+		result = stringcase.CamelCaseWithOptions("E\u0301COLE_E\u0301TE\u0301", opts)
+		assert.Equal(t, result, "e\u0301coleE\u0301te\u0301")
+	})
+
+	t.Run("split before a sequence of uppercase letters with combining marks", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("E\u0301E\u0301e", opts)
+		assert.Equal(t, result, "e\u0301_e\u0301e")
+
+		result = stringcase.TitleCaseWithOptions("E\u0301E\u0301e", opts)
+		assert.Equal(t, result, "E\u0301 E\u0301e")
+	})
+
+	t.Run("case-map combining marks with base letters", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.MacroCaseWithOptions("α\u0345_x", opts)
+		assert.Equal(t, result, "ΑΙ_X")
+
+		result = stringcase.PascalCaseWithOptions("α\u0345_x", opts)
+		assert.Equal(t, result, "Α\u0345X")
+
+		result = stringcase.SnakeCaseWithOptions("ΟΔΟΣ\u0301", opts)
+		assert.Equal(t, result, "οδος\u0301")
+	})
+
+	t.Run("remove combining marks with separators", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("foo-\u0301bar", opts)
+		assert.Equal(t, result, "foo_bar")
+
+		result = stringcase.SnakeCaseWithOptions("\u0301foo", opts)
+		assert.Equal(t, result, "foo")
+	})
+
+	t.Run("keep emoji sequences", func(t *testing.T) {
+		opts := origOpts
+		opts.Keep = "👍👨"
+
+		result := stringcase.SnakeCaseWithOptions("👍\U0001f3fdfooBar", opts)
+		assert.Equal(t, result, "👍\U0001f3fd_foo_bar")
+
+		result = stringcase.KebabCaseWithOptions("a👨\u200d👩\u200d👧b", opts)
+		assert.Equal(t, result, "a👨\u200d👩\u200d👧-b")
+
+		result = stringcase.PascalCaseWithOptions("a👨\u200d👩\u200d👧b", opts)
+		assert.Equal(t, result, "A👨\u200d👩\u200d👧B")
+
+		result = stringcase.KebabCaseWithOptions("a👩\u200d👨b", opts)
+		assert.Equal(t, result, "a-b")
+	})
+
+	t.Run("keep pairs of regional indicators", func(t *testing.T) {
+		opts := origOpts
+		opts.Keep = "🇯"
+
+		result := stringcase.SnakeCaseWithOptions("🇯🇵🇺🇸jp", opts)
+		assert.Equal(t, result, "🇯🇵_jp")
+
+		result = stringcase.SnakeCaseWithOptions("🇺🇸🇯🇵jp", opts)
+		assert.Equal(t, result, "🇯🇵_jp")
+
+		result = stringcase.SnakeCaseWithOptions("🇺🇯🇵jp", opts)
+		assert.Equal(t, result, "jp")
+	})
+
+	t.Run("never join control characters", func(t *testing.T) {
+		opts := origOpts
+		opts.Separators = "\r\n"
+
+		result := stringcase.SnakeCaseWithOptions("foo\r\n\u0301bar", opts)
+		assert.Equal(t, result, "foo_\u0301_bar")
+
+		opts.Separators = "\t\u00ad"
+		result = stringcase.SnakeCaseWithOptions("foo\t\u0301bar\u00adbaz", opts)
+		assert.Equal(t, result, "foo_\u0301_bar_baz")
+	})
+
+	t.Run("keep Hangul syllables", func(t *testing.T) {
+		opts := origOpts
+		opts.Keep = "ᄀᄒ한"
+
+		result := stringcase.SnakeCaseWithOptions("한Foo글", opts)
+		assert.Equal(t, result, "한_foo글")
+
+		result = stringcase.SnakeCaseWithOptions("한ᆫFoo", opts)
+		assert.Equal(t, result, "한ᆫ_foo")
+
+		opts.Keep = "가"
+		result = stringcase.SnakeCaseWithOptions("간Foo", opts)
+		assert.Equal(t, result, "간_foo")
+	})
+
+	t.Run("keep spacing marks and prepended characters", func(t *testing.T) {
+		opts := origOpts
+		opts.Keep = "क\u0600"
+
+		result := stringcase.SnakeCaseWithOptions("कि\u0e33Foo", opts)
+		assert.Equal(t, result, "कि\u0e33_foo")
+
+		result = stringcase.SnakeCaseWithOptions("\u0600\u0661Foo", opts)
+		assert.Equal(t, result, "\u0600\u0661_foo")
+	})
+
+	t.Run("split combining marks without the Unicode option", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = false
+
+		result := stringcase.SnakeCaseWithOptions("cafe\u0301Bar", opts)
+		assert.Equal(t, result, "cafe_bar")
+
+		opts.Keep = "👍"
+		result = stringcase.SnakeCaseWithOptions("👍\U0001f3fdfooBar", opts)
+		assert.Equal(t, result, "👍_foo_bar")
+	})
+}
@@ -0,0 +1,5 @@
+module github.com/sttk/stringcase/internal/gen
+
+go 1.25.0
+
+require golang.org/x/text v0.40.0
@@ -0,0 +1,286 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+// Command gen generates the Unicode data tables of the stringcase package from the tables of
+// golang.org/x/text, which must be of the same Unicode version as the unicode package of the
+// running Go release, so that the tables agree with the character classification of the package.
+//
+// It is run by go generate in the root directory of the stringcase package, and writes the table
+// files into the directory given as the argument.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/runenames"
+)
+
+const header = `// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+// Code generated by internal/gen. DO NOT EDIT.
+
+package stringcase
+`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	if len(os.Args) != 2 {
+		log.Fatal("usage: gen <output directory>")
+	}
+	versions := []string{norm.Version, cases.UnicodeVersion, runenames.UnicodeVersion}
+	for _, version := range versions {
+		if version != unicode.Version {
+			log.Fatalf("golang.org/x/text is of Unicode %s, but the unicode package is of %s",
+				version, unicode.Version)
+		}
+	}
+	dir := os.Args[1]
+
+	writeFile(filepath.Join(dir, "normalization_tables.go"), genNormalizationTables)
+	writeFile(filepath.Join(dir, "special_casing_tables.go"), genSpecialCasingTables)
+	writeFile(filepath.Join(dir, "ascii_fold_tables.go"), genAsciiFoldTables)
+}
+
+func writeFile(path string, gen func(w *bytes.Buffer)) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	gen(&buf)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// quote returns the string as a Go string literal, in which the characters other than printable
+// ASCII characters, double quotes, and backslashes are escaped with \u or \U.
+func quote(s string) string {
+	var b bytes.Buffer
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case 0x20 <= r && r < 0x7f && r != '"' && r != '\\':
+			b.WriteRune(r)
+		case r < 0x10000:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			fmt.Fprintf(&b, `\U%08x`, r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// runes calls f with each character which is a valid scalar value, in ascending order.
+func runes(f func(r rune)) {
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if utf8.ValidRune(r) {
+			f(r)
+		}
+	}
+}
+
+const (
+	hangulSBase  = 0xac00
+	hangulSCount = 11172
+)
+
+func isHangulSyllable(r rune) bool {
+	return hangulSBase <= r && r < hangulSBase+hangulSCount
+}
+
+func genNormalizationTables(w *bytes.Buffer) {
+	fmt.Fprintf(w, `
+// decompositions is the full canonical and compatibility decompositions of the characters in the
+// Unicode Character Database (version %s), sorted by character. The nfd field is empty if the
+// character has no canonical decomposition. Hangul syllables are decomposed algorithmically and
+// are not included.
+var decompositions = [...]decomposition{
+`, norm.Version)
+	runes(func(r rune) {
+		s := string(r)
+		nfd, nfkd := norm.NFD.String(s), norm.NFKD.String(s)
+		if nfkd == s || isHangulSyllable(r) {
+			return
+		}
+		if nfd == s {
+			nfd = ""
+		}
+		fmt.Fprintf(w, "\t{0x%04x, %s, %s},\n", r, quote(nfd), quote(nfkd))
+	})
+	w.WriteString("}\n")
+
+	fmt.Fprintf(w, `
+// combiningClasses is the ranges of characters with non-zero canonical combining classes in the
+// Unicode Character Database (version %s), sorted by character.
+var combiningClasses = [...]combiningClass{
+`, norm.Version)
+	var lo, hi rune
+	var last uint8
+	flush := func() {
+		if last != 0 {
+			fmt.Fprintf(w, "\t{0x%04x, 0x%04x, %d},\n", lo, hi, last)
+		}
+	}
+	runes(func(r rune) {
+		ccc := norm.NFD.PropertiesString(string(r)).CCC()
+		if ccc == last && r == hi+1 {
+			hi = r
+			return
+		}
+		flush()
+		lo, hi, last = r, r, ccc
+	})
+	flush()
+	w.WriteString("}\n")
+
+	fmt.Fprintf(w, `
+// compositions is the pairs of characters which are canonically composed to a primary composite
+// in the Unicode Character Database (version %s), sorted by the pairs.
+var compositions = [...]composition{
+`, norm.Version)
+	type composition struct{ first, second, composed rune }
+	var compositions []composition
+	runes(func(r rune) {
+		s := string(r)
+		if isHangulSyllable(r) || norm.NFC.String(s) != s {
+			return
+		}
+		d := []rune(norm.NFD.String(s))
+		if len(d) < 2 {
+			return
+		}
+		// The canonical decomposition of a primary composite is a pair of a character and the
+		// last character of its full decomposition, which is composed from the rest of it.
+		first := []rune(norm.NFC.String(string(d[:len(d)-1])))
+		second := d[len(d)-1]
+		if len(first) != 1 || norm.NFC.String(string(first)+string(second)) != s {
+			log.Fatalf("no canonical decomposition to a pair is found for U+%04X", r)
+		}
+		compositions = append(compositions, composition{first[0], second, r})
+	})
+	sort.Slice(compositions, func(i, j int) bool {
+		a, b := compositions[i], compositions[j]
+		return a.first < b.first || (a.first == b.first && a.second < b.second)
+	})
+	for _, c := range compositions {
+		fmt.Fprintf(w, "\t{0x%04x, 0x%04x, 0x%04x},\n", c.first, c.second, c.composed)
+	}
+	w.WriteString("}\n")
+}
+
+func genSpecialCasingTables(w *bytes.Buffer) {
+	fmt.Fprintf(w, `
+// specialCasings is the unconditional mappings in SpecialCasing.txt of the Unicode Character
+// Database (version %s), sorted by character. The conditional mappings are handled by
+// appendLocaleCase and isFinalSigma.
+var specialCasings = [...]specialCasing{
+`, cases.UnicodeVersion)
+	lower, title := cases.Lower(language.Und), cases.Title(language.Und)
+	upper := cases.Upper(language.Und)
+	runes(func(r rune) {
+		s := string(r)
+		l, t, u := lower.String(s), title.String(s), upper.String(s)
+		if utf8.RuneCountInString(l) == 1 && utf8.RuneCountInString(t) == 1 &&
+			utf8.RuneCountInString(u) == 1 {
+			return
+		}
+		fmt.Fprintf(w, "\t{0x%04x, %s, %s, %s},\n", r, quote(l), quote(t), quote(u))
+	})
+	w.WriteString("}\n")
+}
+
+// asciiFoldBlocks is the blocks of the Latin letters used in orthographies, whose letters are
+// folded by their names. The blocks of phonetic letters, such as IPA Extensions, are excluded.
+var asciiFoldBlocks = []struct{ lo, hi rune }{
+	{0x0080, 0x00ff}, // Latin-1 Supplement
+	{0x0100, 0x017f}, // Latin Extended-A
+	{0x0180, 0x024f}, // Latin Extended-B
+	{0x1e00, 0x1eff}, // Latin Extended Additional
+	{0x2c60, 0x2c7f}, // Latin Extended-C
+	{0xa720, 0xa7ff}, // Latin Extended-D
+}
+
+// asciiFoldName matches the names of the Latin letters which are ASCII letters with a modifier,
+// such as "LATIN CAPITAL LETTER L WITH STROKE" and "LATIN CAPITAL LETTER U BAR", or ligatures of
+// ASCII letters, such as "LATIN SMALL LIGATURE OE" and "LATIN SMALL LETTER DB DIGRAPH".
+var asciiFoldName = regexp.MustCompile(
+	`^LATIN (CAPITAL|SMALL) (?:LETTER|LIGATURE) (.+?)(?: DIGRAPH| BAR)?(?: WITH .*)?$`)
+
+// asciiFoldLetters is the ASCII letters of the Latin letters whose names are not ASCII letters.
+var asciiFoldLetters = map[string]string{
+	"AFRICAN D": "D",
+	"DOTLESS I": "I",
+	"DOTLESS J": "J",
+	"ETH":       "D",
+	"LONG S":    "S",
+	"SHARP S":   "SS",
+	"THORN":     "TH",
+}
+
+func genAsciiFoldTables(w *bytes.Buffer) {
+	fmt.Fprintf(w, `
+// asciiFoldings is the mappings of the Latin letters which cannot be folded to ASCII letters by
+// removing combining marks from their compatibility decompositions, such as letters with a stroke
+// and ligatures, sorted by character. They are derived from the character names in the Unicode
+// Character Database (version %s).
+var asciiFoldings = [...]asciiFolding{
+`, runenames.UnicodeVersion)
+	for _, block := range asciiFoldBlocks {
+		for r := block.lo; r <= block.hi; r++ {
+			if !unicode.IsLetter(r) || isFoldedByDecomposition(r) {
+				continue
+			}
+			m := asciiFoldName.FindStringSubmatch(runenames.Name(r))
+			if m == nil {
+				continue
+			}
+			folded, ok := asciiFoldLetters[m[2]]
+			if !ok {
+				if len(m[2]) > 2 || strings.Trim(m[2], "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+					continue
+				}
+				folded = m[2]
+			}
+			if m[1] == "SMALL" {
+				folded = strings.ToLower(folded)
+			}
+			fmt.Fprintf(w, "\t{0x%04x, %s},\n", r, quote(folded))
+		}
+	}
+	w.WriteString("}\n")
+}
+
+// isFoldedByDecomposition reports whether the compatibility decomposition of the letter consists
+// of ASCII letters and combining marks, so the letter is folded without the asciiFoldings table.
+func isFoldedByDecomposition(r rune) bool {
+	hasLetter := false
+	for _, c := range norm.NFKD.String(string(r)) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z':
+			hasLetter = true
+		case !unicode.Is(unicode.Mn, c):
+			return false
+		}
+	}
+	return hasLetter
+}
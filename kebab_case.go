@@ -7,7 +7,14 @@ package stringcase
 // KebabCaseWithOptions converts the input string to kebab case with the
 // specified options.
 func KebabCaseWithOptions(input string, opts Options) string {
-	return Lowerize(input, '-', opts)
+	return Format(input, styleKebab, opts)
+}
+
+// KebabCaseWithMapping converts the input string to kebab case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func KebabCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleKebab, opts)
 }
 
 // KebabCase converts the input string to kebab case.
@@ -15,8 +22,30 @@ func KebabCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func KebabCase(input string) string {
-	return Lowerize(input, '-', Options{
+	return Format(input, styleKebab, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// AppendKebabCase appends the input string converted to kebab case to dst
+// and returns the extended buffer, in the same way as KebabCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendKebabCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleKebab, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsKebabCaseWithOptions reports whether the input string is in kebab case
+// with the specified options, that is, whether KebabCaseWithOptions
+// returns it unchanged.
+func IsKebabCaseWithOptions(input string, opts Options) bool {
+	return KebabCaseWithOptions(input, opts) == input
+}
+
+// IsKebabCase reports whether the input string is in kebab case.
+func IsKebabCase(input string) bool {
+	return KebabCase(input) == input
+}
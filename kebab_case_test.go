@@ -1722,3 +1722,82 @@ func TestKebabCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestKebabCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.KebabCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "a-b-c1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.KebabCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsKebabCase(t *testing.T) {
+	t.Run("string in kebab case", func(t *testing.T) {
+		assert.True(t, stringcase.IsKebabCase("user-account-settings"))
+		assert.True(t, stringcase.IsKebabCase(""))
+	})
+
+	t.Run("string not in kebab case", func(t *testing.T) {
+		assert.False(t, stringcase.IsKebabCase("user_account_settings"))
+		assert.False(t, stringcase.IsKebabCase("user-account-settings_"))
+		assert.False(t, stringcase.IsKebabCase("rate%-limit"))
+	})
+}
+
+func TestIsKebabCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in kebab case", func(t *testing.T) {
+		assert.True(t, stringcase.IsKebabCaseWithOptions("rate%-limit", opts))
+		assert.True(t, stringcase.IsKebabCaseWithOptions("user-account-settings", opts))
+	})
+
+	t.Run("string not in kebab case", func(t *testing.T) {
+		assert.False(t, stringcase.IsKebabCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsKebabCaseWithOptions("user-account-settings_", opts))
+	})
+}
+
+func TestAppendKebabCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendKebabCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=user-account-settings")
+
+		buf = stringcase.AppendKebabCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "user-account-settings")
+	})
+
+	t.Run("same result as KebabCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendKebabCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.KebabCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendKebabCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
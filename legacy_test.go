@@ -0,0 +1,1579 @@
+package stringcase
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// legacyCapitalize converts the input string, which is already preprocessed, in the same way as
+// Capitalize, and records the spans of the result into r if r is not nil.
+func legacyCapitalize(input string, joiner rune, opts Options, r *spanRecorder) []byte {
+	result := make([]byte, 0, len(input)+len(input)/2)
+
+	const (
+		ChIsFirstOfStr = iota
+		ChIsNextOfUpper
+		ChIsNextOfContdUpper
+		ChIsNextOfSepMark
+		ChIsNextOfKeptMark
+		ChIsOther
+	)
+	locale := opts.locale()
+	var flag uint8 = ChIsFirstOfStr
+	var prevUpper rune
+	var prevMarks string
+	var prevStart int
+	var runStart int
+	var prevClass uint8
+	var prevPos int
+	var wordStart, wordPos, wordEnd int
+	isDutchIJ := false
+	var script *unicode.RangeTable
+
+	for i := 0; i < len(input); {
+		ch, size := utf8.DecodeRuneInString(input[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(input[i:])
+		}
+		marks := input[i+size : end]
+		isLetter := opts.Unicode && opts.SeparateScripts && unicode.IsLetter(ch)
+		isNewScript := false
+		if isLetter {
+			script, isNewScript = nextScript(script, ch)
+		}
+
+		if len(opts.ProtectedWords.words()) > 0 {
+			class := charClassOf(ch, &opts)
+			isPrevUpperHead := class == charIsLower && flag == ChIsNextOfContdUpper &&
+				opts.separatesAcronyms() && !isPluralSuffix(input, runStart, i, end, &opts)
+			word, pos := "", i
+			if isPrevUpperHead {
+				word, pos = matchProtectedWord(input, prevPos, &opts), prevPos
+			}
+			if len(word) == 0 && class != charIsSepMark && (flag == ChIsFirstOfStr ||
+				flag == ChIsNextOfSepMark || isNewScript ||
+				(opts.isWordBoundary(prevClass, class) && !(isDutchIJ && isDutchJ(ch)))) {
+				word, pos = matchProtectedWord(input, i, &opts), i
+			}
+			if len(word) > 0 {
+				if isPrevUpperHead {
+					result = result[:prevStart]
+					r.truncate(prevPos)
+					wordEnd = prevPos
+				}
+				if isPrevUpperHead && pos == i {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), prevPos)
+					wordStart, wordPos = len(result), prevPos
+					result = appendCasedLetter(result, prevUpper, prevMarks, mapToTitle, locale)
+					r.add(len(result), prevPos, i)
+					wordEnd = i
+				}
+				end = pos + len(word)
+				if flag != ChIsFirstOfStr {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), pos)
+				}
+				result = append(result, word...)
+				r.add(len(result), pos, end)
+				flag = ChIsNextOfSepMark
+				isDutchIJ = false
+				wordStart, wordPos, wordEnd = len(result), end, end
+				i = end
+				continue
+			}
+		}
+
+		if isUpperCase(ch, opts.Unicode) {
+			if flag != ChIsNextOfUpper && flag != ChIsNextOfContdUpper {
+				runStart = i
+			}
+			if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = false
+			} else if flag == ChIsFirstOfStr {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if flag != ChIsFirstOfStr && flag != ChIsNextOfSepMark &&
+				!opts.isWordBoundary(prevClass, charIsUpper) && !isNewScript {
+				prevStart = len(result)
+				prevPos = i
+				if ch == capitalSigma && (prevClass == charIsUpper || prevClass == charIsLower) &&
+					isFinalSigma(input, runStart, end, &opts) {
+					result = utf8.AppendRune(result, finalSigma)
+					result = appendCasedMarks(result, ch, marks, mapToLower, locale)
+				} else {
+					result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				}
+				if prevClass == charIsUpper || opts.Boundaries == 0 {
+					flag = ChIsNextOfContdUpper
+				} else {
+					flag = ChIsNextOfUpper
+				}
+				prevUpper = ch
+				prevMarks = marks
+				isDutchIJ = false
+			} else {
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			}
+			prevClass = charIsUpper
+			wordEnd = end
+		} else if isLowerCase(ch, opts.Unicode) {
+			if flag == ChIsFirstOfStr {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if flag == ChIsNextOfContdUpper && opts.separatesAcronyms() &&
+				!isPluralSuffix(input, runStart, i, end, &opts) {
+				r.truncate(prevPos)
+				result = result[:prevStart]
+				result = uppercaseAcronym(result, wordStart, input[wordPos:prevPos], &opts, r)
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), prevPos)
+				wordStart, wordPos = len(result), prevPos
+				result = appendCasedLetter(result, prevUpper, prevMarks, mapToTitle, locale)
+				r.add(len(result), prevPos, i)
+				if isNewScript {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), i)
+					wordStart, wordPos = len(result), i
+					result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+					isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				} else if locale == LocaleDutch && isDutchI(prevUpper) && isDutchJ(ch) {
+					result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+					isDutchIJ = false
+				} else {
+					result = append(result, input[i:end]...)
+					isDutchIJ = false
+				}
+			} else if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsLower) ||
+				isNewScript {
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = false
+			} else {
+				result = append(result, input[i:end]...)
+				isDutchIJ = false
+			}
+			flag = ChIsOther
+			prevClass = charIsLower
+			wordEnd = end
+		} else if isLetter {
+			if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsCaseless) ||
+				isNewScript {
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+			}
+			result = append(result, input[i:end]...)
+			flag = ChIsOther
+			prevClass = charIsCaseless
+			isDutchIJ = false
+			wordEnd = end
+		} else {
+			isDutchIJ = false
+			isKeptChar := false
+			class := charIsKeptMark
+			if isDigit(ch, opts.Unicode) {
+				isKeptChar = true
+				class = charIsDigit
+			} else if len(opts.Separators) > 0 {
+				if !strings.ContainsRune(opts.Separators, ch) {
+					isKeptChar = true
+				}
+			} else if len(opts.Keep) > 0 {
+				if strings.ContainsRune(opts.Keep, ch) {
+					isKeptChar = true
+				}
+			}
+
+			if isKeptChar {
+				if flag == ChIsNextOfSepMark ||
+					(flag != ChIsFirstOfStr && opts.isWordBoundary(prevClass, class)) {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), i)
+					wordStart, wordPos = len(result), i
+				}
+				result = append(result, input[i:end]...)
+				flag = ChIsNextOfKeptMark
+				prevClass = class
+				wordEnd = end
+			} else {
+				if flag != ChIsFirstOfStr {
+					flag = ChIsNextOfSepMark
+				} else {
+					wordPos, wordEnd = end, end
+				}
+			}
+		}
+
+		r.add(len(result), i, end)
+		i = end
+	}
+
+	result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+	return result
+}
+
+// legacyLowerize converts the input string, which is already preprocessed, in the same way as Lowerize,
+// and records the spans of the result into r if r is not nil.
+func legacyLowerize(input string, joiner rune, opts Options, r *spanRecorder) []byte {
+	result := make([]byte, 0, len(input)+len(input)/2)
+
+	const (
+		ChIsFirstOfStr = iota
+		ChIsNextOfUpper
+		ChIsNextOfContdUpper
+		ChIsNextOfSepMark
+		ChIsNextOfKeptMark
+		ChIsOther
+	)
+	locale := opts.locale()
+	var flag uint8 = ChIsFirstOfStr
+	var prevUpper rune
+	var prevMarks string
+	var prevStart int
+	var runStart int
+	var prevClass uint8
+	var prevPos int
+	isDutchIJ := false
+	var script *unicode.RangeTable
+
+	for i := 0; i < len(input); {
+		ch, size := utf8.DecodeRuneInString(input[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(input[i:])
+		}
+		marks := input[i+size : end]
+		isLetter := opts.Unicode && opts.SeparateScripts && unicode.IsLetter(ch)
+		isNewScript := false
+		if isLetter {
+			script, isNewScript = nextScript(script, ch)
+		}
+
+		if len(opts.ProtectedWords.words()) > 0 {
+			class := charClassOf(ch, &opts)
+			isPrevUpperHead := class == charIsLower && flag == ChIsNextOfContdUpper &&
+				opts.separatesAcronyms() && !isPluralSuffix(input, runStart, i, end, &opts)
+			word, pos := "", i
+			if isPrevUpperHead {
+				word, pos = matchProtectedWord(input, prevPos, &opts), prevPos
+			}
+			if len(word) == 0 && class != charIsSepMark && (flag == ChIsFirstOfStr ||
+				flag == ChIsNextOfSepMark || isNewScript ||
+				(opts.isWordBoundary(prevClass, class) && !(isDutchIJ && isDutchJ(ch)))) {
+				word, pos = matchProtectedWord(input, i, &opts), i
+			}
+			if len(word) > 0 {
+				if isPrevUpperHead {
+					result = result[:prevStart]
+					r.truncate(prevPos)
+				}
+				if isPrevUpperHead && pos == i {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), prevPos)
+					result = appendCasedLetter(result, prevUpper, prevMarks, mapToLower, locale)
+					r.add(len(result), prevPos, i)
+				}
+				end = pos + len(word)
+				if flag != ChIsFirstOfStr {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), pos)
+				}
+				result = appendProtectedWord(result, input[pos:end], mapToLower, &opts)
+				r.add(len(result), pos, end)
+				flag = ChIsNextOfSepMark
+				isDutchIJ = false
+				i = end
+				continue
+			}
+		}
+
+		if isUpperCase(ch, opts.Unicode) {
+			if flag != ChIsNextOfUpper && flag != ChIsNextOfContdUpper {
+				runStart = i
+			}
+			if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = false
+			} else if flag == ChIsFirstOfStr {
+				result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if flag != ChIsFirstOfStr && flag != ChIsNextOfSepMark &&
+				!opts.isWordBoundary(prevClass, charIsUpper) && !isNewScript {
+				prevStart = len(result)
+				prevPos = i
+				if ch == capitalSigma && (prevClass == charIsUpper || prevClass == charIsLower) &&
+					isFinalSigma(input, runStart, end, &opts) {
+					result = utf8.AppendRune(result, finalSigma)
+					result = appendCasedMarks(result, ch, marks, mapToLower, locale)
+				} else {
+					result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				}
+				if prevClass == charIsUpper || opts.Boundaries == 0 {
+					flag = ChIsNextOfContdUpper
+				} else {
+					flag = ChIsNextOfUpper
+				}
+				prevUpper = ch
+				prevMarks = marks
+				isDutchIJ = false
+			} else {
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			}
+			prevClass = charIsUpper
+		} else if isLowerCase(ch, opts.Unicode) {
+			if flag == ChIsNextOfContdUpper && opts.separatesAcronyms() &&
+				!isPluralSuffix(input, runStart, i, end, &opts) {
+				r.truncate(prevPos)
+				result = utf8.AppendRune(result[:prevStart], joiner)
+				r.join(len(result), prevPos)
+				result = appendCasedLetter(result, prevUpper, prevMarks, mapToLower, locale)
+				r.add(len(result), prevPos, i)
+				if isNewScript {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), i)
+					result = append(result, input[i:end]...)
+					isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				} else {
+					result = append(result, input[i:end]...)
+					isDutchIJ = false
+				}
+			} else if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsLower) ||
+				isNewScript {
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				result = append(result, input[i:end]...)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else {
+				result = append(result, input[i:end]...)
+				isDutchIJ = flag == ChIsFirstOfStr && locale == LocaleDutch && isDutchI(ch)
+			}
+			flag = ChIsOther
+			prevClass = charIsLower
+		} else if isLetter {
+			if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsCaseless) ||
+				isNewScript {
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+			}
+			result = append(result, input[i:end]...)
+			flag = ChIsOther
+			prevClass = charIsCaseless
+			isDutchIJ = false
+		} else {
+			isDutchIJ = false
+			isKeptChar := false
+			class := charIsKeptMark
+			if isDigit(ch, opts.Unicode) {
+				isKeptChar = true
+				class = charIsDigit
+			} else if len(opts.Separators) > 0 {
+				if !strings.ContainsRune(opts.Separators, ch) {
+					isKeptChar = true
+				}
+			} else if len(opts.Keep) > 0 {
+				if strings.ContainsRune(opts.Keep, ch) {
+					isKeptChar = true
+				}
+			}
+
+			if isKeptChar {
+				if flag == ChIsNextOfSepMark ||
+					(flag != ChIsFirstOfStr && opts.isWordBoundary(prevClass, class)) {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), i)
+				}
+				result = append(result, input[i:end]...)
+				flag = ChIsNextOfKeptMark
+				prevClass = class
+			} else {
+				if flag != ChIsFirstOfStr {
+					flag = ChIsNextOfSepMark
+				}
+			}
+		}
+
+		r.add(len(result), i, end)
+		i = end
+	}
+
+	return result
+}
+
+// legacyUpperize converts the input string, which is already preprocessed, in the same way as Upperize,
+// and records the spans of the result into r if r is not nil.
+func legacyUpperize(input string, joiner rune, opts Options, r *spanRecorder) []byte {
+	result := make([]byte, 0, len(input)+len(input)/2)
+
+	const (
+		ChIsFirstOfStr = iota
+		ChIsNextOfUpper
+		ChIsNextOfContdUpper
+		ChIsNextOfSepMark
+		ChIsNextOfKeptMark
+		ChIsOther
+	)
+	locale := opts.locale()
+	var flag uint8 = ChIsFirstOfStr
+	var prevUpper rune
+	var prevMarks string
+	var prevStart int
+	var runStart int
+	var prevClass uint8
+	var prevPos int
+	isDutchIJ := false
+	var script *unicode.RangeTable
+
+	for i := 0; i < len(input); {
+		ch, size := utf8.DecodeRuneInString(input[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(input[i:])
+		}
+		marks := input[i+size : end]
+		isLetter := opts.Unicode && opts.SeparateScripts && unicode.IsLetter(ch)
+		isNewScript := false
+		if isLetter {
+			script, isNewScript = nextScript(script, ch)
+		}
+
+		if len(opts.ProtectedWords.words()) > 0 {
+			class := charClassOf(ch, &opts)
+			isPrevUpperHead := class == charIsLower && flag == ChIsNextOfContdUpper &&
+				opts.separatesAcronyms() && !isPluralSuffix(input, runStart, i, end, &opts)
+			word, pos := "", i
+			if isPrevUpperHead {
+				word, pos = matchProtectedWord(input, prevPos, &opts), prevPos
+			}
+			if len(word) == 0 && class != charIsSepMark && (flag == ChIsFirstOfStr ||
+				flag == ChIsNextOfSepMark || isNewScript ||
+				(opts.isWordBoundary(prevClass, class) && !(isDutchIJ && isDutchJ(ch)))) {
+				word, pos = matchProtectedWord(input, i, &opts), i
+			}
+			if len(word) > 0 {
+				if isPrevUpperHead {
+					result = result[:prevStart]
+					r.truncate(prevPos)
+				}
+				if isPrevUpperHead && pos == i {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), prevPos)
+					result = appendCasedLetter(result, prevUpper, prevMarks, mapToUpper, locale)
+					r.add(len(result), prevPos, i)
+				}
+				end = pos + len(word)
+				if flag != ChIsFirstOfStr {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), pos)
+				}
+				result = appendProtectedWord(result, input[pos:end], mapToUpper, &opts)
+				r.add(len(result), pos, end)
+				flag = ChIsNextOfSepMark
+				isDutchIJ = false
+				i = end
+				continue
+			}
+		}
+
+		if isUpperCase(ch, opts.Unicode) {
+			if flag != ChIsNextOfUpper && flag != ChIsNextOfContdUpper {
+				runStart = i
+			}
+			if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = false
+			} else if flag == ChIsFirstOfStr {
+				result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if flag != ChIsFirstOfStr && flag != ChIsNextOfSepMark &&
+				!opts.isWordBoundary(prevClass, charIsUpper) && !isNewScript {
+				prevStart = len(result)
+				prevPos = i
+				result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+				if prevClass == charIsUpper || opts.Boundaries == 0 {
+					flag = ChIsNextOfContdUpper
+				} else {
+					flag = ChIsNextOfUpper
+				}
+				prevUpper = ch
+				prevMarks = marks
+				isDutchIJ = false
+			} else {
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			}
+			prevClass = charIsUpper
+		} else if isLowerCase(ch, opts.Unicode) {
+			if flag == ChIsNextOfContdUpper && opts.separatesAcronyms() &&
+				!isPluralSuffix(input, runStart, i, end, &opts) {
+				r.truncate(prevPos)
+				result = utf8.AppendRune(result[:prevStart], joiner)
+				r.join(len(result), prevPos)
+				result = appendCasedLetter(result, prevUpper, prevMarks, mapToUpper, locale)
+				r.add(len(result), prevPos, i)
+				if isNewScript {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), i)
+					result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+					isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				} else {
+					result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+					isDutchIJ = false
+				}
+			} else if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsLower) ||
+				isNewScript {
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+				result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else {
+				result = appendCasedLetter(result, ch, marks, mapToUpper, locale)
+				isDutchIJ = flag == ChIsFirstOfStr && locale == LocaleDutch && isDutchI(ch)
+			}
+			flag = ChIsOther
+			prevClass = charIsLower
+		} else if isLetter {
+			if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsCaseless) ||
+				isNewScript {
+				result = utf8.AppendRune(result, joiner)
+				r.join(len(result), i)
+			}
+			result = append(result, input[i:end]...)
+			flag = ChIsOther
+			prevClass = charIsCaseless
+			isDutchIJ = false
+		} else {
+			isDutchIJ = false
+			isKeptChar := false
+			class := charIsKeptMark
+			if isDigit(ch, opts.Unicode) {
+				isKeptChar = true
+				class = charIsDigit
+			} else if len(opts.Separators) > 0 {
+				if !strings.ContainsRune(opts.Separators, ch) {
+					isKeptChar = true
+				}
+			} else if len(opts.Keep) > 0 {
+				if strings.ContainsRune(opts.Keep, ch) {
+					isKeptChar = true
+				}
+			}
+
+			if isKeptChar {
+				if flag == ChIsNextOfSepMark ||
+					(flag != ChIsFirstOfStr && opts.isWordBoundary(prevClass, class)) {
+					result = utf8.AppendRune(result, joiner)
+					r.join(len(result), i)
+				}
+				result = append(result, input[i:end]...)
+				flag = ChIsNextOfKeptMark
+				prevClass = class
+			} else {
+				if flag != ChIsFirstOfStr {
+					flag = ChIsNextOfSepMark
+				}
+			}
+		}
+
+		r.add(len(result), i, end)
+		i = end
+	}
+
+	return result
+}
+
+// legacyCamelCase converts the input string, which is already preprocessed, in
+// the same way as CamelCaseWithOptions, and records the spans of the
+// result into r if r is not nil.
+func legacyCamelCase(input string, opts Options, r *spanRecorder) []byte {
+	result := make([]byte, 0, len(input))
+
+	const (
+		ChIsFirstOfStr = iota
+		ChIsNextOfUpper
+		ChIsNextOfContdUpper
+		ChIsNextOfSepMark
+		ChIsNextOfKeptMark
+		ChIsOther
+	)
+	locale := opts.locale()
+	var flag uint8 = ChIsFirstOfStr
+	var prevUpper rune
+	var prevMarks string
+	var prevStart int
+	var runStart int
+	var prevClass uint8
+	var prevPos int
+	var wordStart, wordPos, wordEnd int
+	isFirstWord := true
+	isDutchIJ := false
+	ijMapping := mapToLower
+	var script *unicode.RangeTable
+
+	for i := 0; i < len(input); {
+		ch, size := utf8.DecodeRuneInString(input[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(input[i:])
+		}
+		marks := input[i+size : end]
+		isLetter := opts.Unicode && opts.SeparateScripts && unicode.IsLetter(ch)
+		isNewScript := false
+		if isLetter {
+			script, isNewScript = nextScript(script, ch)
+		}
+
+		if len(opts.ProtectedWords.words()) > 0 {
+			class := charClassOf(ch, &opts)
+			isPrevUpperHead := class == charIsLower && flag == ChIsNextOfContdUpper &&
+				opts.separatesAcronyms() && !isPluralSuffix(input, runStart, i, end, &opts)
+			word, pos := "", i
+			if isPrevUpperHead {
+				word, pos = matchProtectedWord(input, prevPos, &opts), prevPos
+			}
+			if len(word) == 0 && class != charIsSepMark && (flag == ChIsFirstOfStr ||
+				flag == ChIsNextOfSepMark || isNewScript ||
+				(opts.isWordBoundary(prevClass, class) && !(isDutchIJ && isDutchJ(ch)))) {
+				word, pos = matchProtectedWord(input, i, &opts), i
+			}
+			if len(word) > 0 {
+				if isPrevUpperHead {
+					result = result[:prevStart]
+					r.truncate(prevPos)
+					wordEnd = prevPos
+				}
+				if isPrevUpperHead && pos == i {
+					if !isFirstWord {
+						result = uppercaseAcronym(
+							result, wordStart, input[wordPos:wordEnd], &opts, r,
+						)
+					}
+					isFirstWord = false
+					r.join(len(result), prevPos)
+					wordStart, wordPos = len(result), prevPos
+					result = appendCasedLetter(result, prevUpper, prevMarks, mapToTitle, locale)
+					r.add(len(result), prevPos, i)
+					wordEnd = i
+				}
+				end = pos + len(word)
+				if flag != ChIsFirstOfStr {
+					if !isFirstWord {
+						result = uppercaseAcronym(
+							result, wordStart, input[wordPos:wordEnd], &opts, r,
+						)
+					}
+					isFirstWord = false
+					r.join(len(result), pos)
+				}
+				head, _ := utf8.DecodeRuneInString(word)
+				if isFirstWord && !isLowerCase(head, opts.isUnicode()) {
+					result = appendProtectedWord(result, input[pos:end], mapToLower, &opts)
+				} else {
+					result = append(result, word...)
+				}
+				r.add(len(result), pos, end)
+				flag = ChIsNextOfSepMark
+				isDutchIJ = false
+				wordStart, wordPos, wordEnd = len(result), end, end
+				i = end
+				continue
+			}
+		}
+
+		if isUpperCase(ch, opts.Unicode) {
+			if flag != ChIsNextOfUpper && flag != ChIsNextOfContdUpper {
+				runStart = i
+			}
+			if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, ijMapping, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = false
+			} else if flag == ChIsFirstOfStr {
+				result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				ijMapping = mapToLower
+			} else if flag != ChIsFirstOfStr && flag != ChIsNextOfSepMark &&
+				!opts.isWordBoundary(prevClass, charIsUpper) && !isNewScript {
+				prevStart = len(result)
+				prevPos = i
+				if ch == capitalSigma && (prevClass == charIsUpper || prevClass == charIsLower) &&
+					isFinalSigma(input, runStart, end, &opts) {
+					result = utf8.AppendRune(result, finalSigma)
+					result = appendCasedMarks(result, ch, marks, mapToLower, locale)
+				} else {
+					result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				}
+				if prevClass == charIsUpper || opts.Boundaries == 0 {
+					flag = ChIsNextOfContdUpper
+				} else {
+					flag = ChIsNextOfUpper
+				}
+				prevUpper = ch
+				prevMarks = marks
+				isDutchIJ = false
+			} else {
+				if !isFirstWord {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				}
+				isFirstWord = false
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				ijMapping = mapToTitle
+			}
+			prevClass = charIsUpper
+			wordEnd = end
+		} else if isLowerCase(ch, opts.Unicode) {
+			if flag == ChIsNextOfContdUpper && opts.separatesAcronyms() &&
+				!isPluralSuffix(input, runStart, i, end, &opts) {
+				r.truncate(prevPos)
+				result = result[:prevStart]
+				if !isFirstWord {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:prevPos], &opts, r)
+				}
+				isFirstWord = false
+				r.join(len(result), prevPos)
+				wordStart, wordPos = len(result), prevPos
+				result = appendCasedLetter(result, prevUpper, prevMarks, mapToTitle, locale)
+				r.add(len(result), prevPos, i)
+				if isNewScript {
+					if !isFirstWord {
+						result = uppercaseAcronym(
+							result, wordStart, input[wordPos:wordEnd], &opts, r,
+						)
+					}
+					isFirstWord = false
+					r.join(len(result), i)
+					wordStart, wordPos = len(result), i
+					result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+					isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+					ijMapping = mapToTitle
+				} else if locale == LocaleDutch && isDutchI(prevUpper) && isDutchJ(ch) {
+					result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+					isDutchIJ = false
+				} else {
+					result = append(result, input[i:end]...)
+					isDutchIJ = false
+				}
+			} else if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsLower) ||
+				isNewScript {
+				if !isFirstWord {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				}
+				isFirstWord = false
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				ijMapping = mapToTitle
+			} else if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, ijMapping, locale)
+				isDutchIJ = false
+			} else {
+				result = append(result, input[i:end]...)
+				isDutchIJ = flag == ChIsFirstOfStr && locale == LocaleDutch && isDutchI(ch)
+				ijMapping = mapToLower
+			}
+			flag = ChIsOther
+			prevClass = charIsLower
+			wordEnd = end
+		} else if isLetter {
+			if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsCaseless) ||
+				isNewScript {
+				if !isFirstWord {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				}
+				isFirstWord = false
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+			}
+			result = append(result, input[i:end]...)
+			flag = ChIsOther
+			prevClass = charIsCaseless
+			isDutchIJ = false
+			wordEnd = end
+		} else {
+			isDutchIJ = false
+			isKeptChar := false
+			class := charIsKeptMark
+			if isDigit(ch, opts.Unicode) {
+				isKeptChar = true
+				class = charIsDigit
+			} else if len(opts.Separators) > 0 {
+				if !strings.ContainsRune(opts.Separators, ch) {
+					isKeptChar = true
+				}
+			} else if len(opts.Keep) > 0 {
+				if strings.ContainsRune(opts.Keep, ch) {
+					isKeptChar = true
+				}
+			}
+
+			if isKeptChar {
+				if flag == ChIsNextOfSepMark ||
+					(flag != ChIsFirstOfStr && opts.isWordBoundary(prevClass, class)) {
+					if !isFirstWord {
+						result = uppercaseAcronym(
+							result, wordStart, input[wordPos:wordEnd], &opts, r,
+						)
+					}
+					isFirstWord = false
+					r.join(len(result), i)
+					wordStart, wordPos = len(result), i
+				}
+				result = append(result, input[i:end]...)
+				flag = ChIsNextOfKeptMark
+				prevClass = class
+				wordEnd = end
+			} else {
+				if flag != ChIsFirstOfStr {
+					flag = ChIsNextOfSepMark
+				} else {
+					wordPos, wordEnd = end, end
+				}
+			}
+		}
+
+		r.add(len(result), i, end)
+		i = end
+	}
+
+	if !isFirstWord {
+		result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+	}
+	return result
+}
+
+// legacyPascalCase converts the input string, which is already preprocessed,
+// in the same way as PascalCaseWithOptions, and records the spans of the
+// result into r if r is not nil.
+func legacyPascalCase(input string, opts Options, r *spanRecorder) []byte {
+	result := make([]byte, 0, len(input))
+
+	const (
+		ChIsFirstOfStr = iota
+		ChIsNextOfUpper
+		ChIsNextOfContdUpper
+		ChIsNextOfSepMark
+		ChIsNextOfKeptMark
+		ChIsOther
+	)
+	locale := opts.locale()
+	var flag uint8 = ChIsFirstOfStr
+	var prevUpper rune
+	var prevMarks string
+	var prevStart int
+	var runStart int
+	var prevClass uint8
+	var prevPos int
+	var wordStart, wordPos, wordEnd int
+	isDutchIJ := false
+	var script *unicode.RangeTable
+
+	for i := 0; i < len(input); {
+		ch, size := utf8.DecodeRuneInString(input[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(input[i:])
+		}
+		marks := input[i+size : end]
+		isLetter := opts.Unicode && opts.SeparateScripts && unicode.IsLetter(ch)
+		isNewScript := false
+		if isLetter {
+			script, isNewScript = nextScript(script, ch)
+		}
+
+		if len(opts.ProtectedWords.words()) > 0 {
+			class := charClassOf(ch, &opts)
+			isPrevUpperHead := class == charIsLower && flag == ChIsNextOfContdUpper &&
+				opts.separatesAcronyms() && !isPluralSuffix(input, runStart, i, end, &opts)
+			word, pos := "", i
+			if isPrevUpperHead {
+				word, pos = matchProtectedWord(input, prevPos, &opts), prevPos
+			}
+			if len(word) == 0 && class != charIsSepMark && (flag == ChIsFirstOfStr ||
+				flag == ChIsNextOfSepMark || isNewScript ||
+				(opts.isWordBoundary(prevClass, class) && !(isDutchIJ && isDutchJ(ch)))) {
+				word, pos = matchProtectedWord(input, i, &opts), i
+			}
+			if len(word) > 0 {
+				if isPrevUpperHead {
+					result = result[:prevStart]
+					r.truncate(prevPos)
+					wordEnd = prevPos
+				}
+				if isPrevUpperHead && pos == i {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					r.join(len(result), prevPos)
+					wordStart, wordPos = len(result), prevPos
+					result = appendCasedLetter(result, prevUpper, prevMarks, mapToTitle, locale)
+					r.add(len(result), prevPos, i)
+					wordEnd = i
+				}
+				end = pos + len(word)
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				r.join(len(result), pos)
+				result = append(result, word...)
+				r.add(len(result), pos, end)
+				flag = ChIsNextOfSepMark
+				isDutchIJ = false
+				wordStart, wordPos, wordEnd = len(result), end, end
+				i = end
+				continue
+			}
+		}
+
+		if isUpperCase(ch, opts.Unicode) {
+			if flag != ChIsNextOfUpper && flag != ChIsNextOfContdUpper {
+				runStart = i
+			}
+			if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = false
+			} else if flag != ChIsFirstOfStr && flag != ChIsNextOfSepMark &&
+				!opts.isWordBoundary(prevClass, charIsUpper) && !isNewScript {
+				prevStart = len(result)
+				prevPos = i
+				if ch == capitalSigma && (prevClass == charIsUpper || prevClass == charIsLower) &&
+					isFinalSigma(input, runStart, end, &opts) {
+					result = utf8.AppendRune(result, finalSigma)
+					result = appendCasedMarks(result, ch, marks, mapToLower, locale)
+				} else {
+					result = appendCasedLetter(result, ch, marks, mapToLower, locale)
+				}
+				if prevClass == charIsUpper || opts.Boundaries == 0 {
+					flag = ChIsNextOfContdUpper
+				} else {
+					flag = ChIsNextOfUpper
+				}
+				prevUpper = ch
+				prevMarks = marks
+				isDutchIJ = false
+			} else {
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			}
+			prevClass = charIsUpper
+			wordEnd = end
+		} else if isLowerCase(ch, opts.Unicode) {
+			if flag == ChIsFirstOfStr {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if flag == ChIsNextOfContdUpper && opts.separatesAcronyms() &&
+				!isPluralSuffix(input, runStart, i, end, &opts) {
+				r.truncate(prevPos)
+				result = result[:prevStart]
+				result = uppercaseAcronym(result, wordStart, input[wordPos:prevPos], &opts, r)
+				r.join(len(result), prevPos)
+				wordStart, wordPos = len(result), prevPos
+				result = appendCasedLetter(result, prevUpper, prevMarks, mapToTitle, locale)
+				r.add(len(result), prevPos, i)
+				if isNewScript {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					r.join(len(result), i)
+					wordStart, wordPos = len(result), i
+					result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+					isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+				} else if locale == LocaleDutch && isDutchI(prevUpper) && isDutchJ(ch) {
+					result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+					isDutchIJ = false
+				} else {
+					result = append(result, input[i:end]...)
+					isDutchIJ = false
+				}
+			} else if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsLower) ||
+				isNewScript {
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if isDutchIJ && isDutchJ(ch) {
+				result = appendCasedLetter(result, ch, marks, mapToTitle, locale)
+				isDutchIJ = false
+			} else {
+				result = append(result, input[i:end]...)
+				isDutchIJ = false
+			}
+			flag = ChIsOther
+			prevClass = charIsLower
+			wordEnd = end
+		} else if isLetter {
+			if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsCaseless) ||
+				isNewScript {
+				result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+				r.join(len(result), i)
+				wordStart, wordPos = len(result), i
+			}
+			result = append(result, input[i:end]...)
+			flag = ChIsOther
+			prevClass = charIsCaseless
+			isDutchIJ = false
+			wordEnd = end
+		} else {
+			isDutchIJ = false
+			isKeptChar := false
+			class := charIsKeptMark
+			if isDigit(ch, opts.Unicode) {
+				isKeptChar = true
+				class = charIsDigit
+			} else if len(opts.Separators) > 0 {
+				if !strings.ContainsRune(opts.Separators, ch) {
+					isKeptChar = true
+				}
+			} else if len(opts.Keep) > 0 {
+				if strings.ContainsRune(opts.Keep, ch) {
+					isKeptChar = true
+				}
+			}
+
+			if isKeptChar {
+				if flag == ChIsNextOfSepMark ||
+					(flag != ChIsFirstOfStr && opts.isWordBoundary(prevClass, class)) {
+					result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+					r.join(len(result), i)
+					wordStart, wordPos = len(result), i
+				}
+				result = append(result, input[i:end]...)
+				flag = ChIsNextOfKeptMark
+				prevClass = class
+				wordEnd = end
+			} else {
+				if flag != ChIsFirstOfStr {
+					flag = ChIsNextOfSepMark
+				} else {
+					wordPos, wordEnd = end, end
+				}
+			}
+		}
+
+		r.add(len(result), i, end)
+		i = end
+	}
+
+	result = uppercaseAcronym(result, wordStart, input[wordPos:wordEnd], &opts, r)
+	return result
+}
+
+// legacyAppendTokens splits the input string, which is already preprocessed, into tokens at the same
+// word boundaries as the conversion functions find, and appends them to tokens.
+func legacyAppendTokens(tokens []Token, input string, opts *Options) []Token {
+	const (
+		ChIsFirstOfStr = iota
+		ChIsNextOfUpper
+		ChIsNextOfContdUpper
+		ChIsNextOfSepMark
+		ChIsNextOfKeptMark
+		ChIsOther
+	)
+	locale := opts.locale()
+	var flag uint8 = ChIsFirstOfStr
+	var runStart int
+	var prevClass uint8
+	var prevPos int
+	isDutchIJ := false
+	var script *unicode.RangeTable
+
+	for i := 0; i < len(input); {
+		ch, size := utf8.DecodeRuneInString(input[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(input[i:])
+		}
+		isLetter := opts.Unicode && opts.SeparateScripts && unicode.IsLetter(ch)
+		isNewScript := false
+		if isLetter {
+			script, isNewScript = nextScript(script, ch)
+		}
+
+		if len(opts.ProtectedWords.words()) > 0 {
+			class := charClassOf(ch, opts)
+			isPrevUpperHead := class == charIsLower && flag == ChIsNextOfContdUpper &&
+				opts.separatesAcronyms() && !isPluralSuffix(input, runStart, i, end, opts)
+			word, pos := "", i
+			if isPrevUpperHead {
+				word, pos = matchProtectedWord(input, prevPos, opts), prevPos
+			}
+			if len(word) == 0 && class != charIsSepMark && (flag == ChIsFirstOfStr ||
+				flag == ChIsNextOfSepMark || isNewScript ||
+				(opts.isWordBoundary(prevClass, class) && !(isDutchIJ && isDutchJ(ch)))) {
+				word, pos = matchProtectedWord(input, i, opts), i
+			}
+			if len(word) > 0 {
+				end = pos + len(word)
+				token := Token{Kind: TokenWord, Start: pos, End: end, WordStart: true}
+				if isPrevUpperHead {
+					tokens = splitLastToken(tokens, prevPos)
+				}
+				if isPrevUpperHead && pos == prevPos {
+					tokens[len(tokens)-1] = token
+				} else {
+					tokens = append(tokens, token)
+				}
+				flag = ChIsNextOfSepMark
+				isDutchIJ = false
+				i = end
+				continue
+			}
+		}
+
+		kind := TokenWord
+		wordStart := false
+
+		if isUpperCase(ch, opts.Unicode) {
+			if flag != ChIsNextOfUpper && flag != ChIsNextOfContdUpper {
+				runStart = i
+			}
+			if isDutchIJ && isDutchJ(ch) {
+				flag = ChIsNextOfUpper
+				isDutchIJ = false
+			} else if flag != ChIsFirstOfStr && flag != ChIsNextOfSepMark &&
+				!opts.isWordBoundary(prevClass, charIsUpper) && !isNewScript {
+				prevPos = i
+				if prevClass == charIsUpper || opts.Boundaries == 0 {
+					flag = ChIsNextOfContdUpper
+				} else {
+					flag = ChIsNextOfUpper
+				}
+				isDutchIJ = false
+			} else {
+				wordStart = true
+				flag = ChIsNextOfUpper
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			}
+			prevClass = charIsUpper
+		} else if isLowerCase(ch, opts.Unicode) {
+			if flag == ChIsFirstOfStr {
+				wordStart = true
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else if flag == ChIsNextOfContdUpper && opts.separatesAcronyms() &&
+				!isPluralSuffix(input, runStart, i, end, opts) {
+				tokens = splitLastToken(tokens, prevPos)
+				wordStart = isNewScript
+				isDutchIJ = isNewScript && locale == LocaleDutch && isDutchI(ch)
+			} else if flag == ChIsNextOfSepMark || opts.isWordBoundary(prevClass, charIsLower) ||
+				isNewScript {
+				wordStart = true
+				isDutchIJ = locale == LocaleDutch && isDutchI(ch)
+			} else {
+				isDutchIJ = false
+			}
+			flag = ChIsOther
+			prevClass = charIsLower
+		} else if isLetter {
+			wordStart = flag == ChIsFirstOfStr || flag == ChIsNextOfSepMark ||
+				opts.isWordBoundary(prevClass, charIsCaseless) || isNewScript
+			flag = ChIsOther
+			prevClass = charIsCaseless
+			isDutchIJ = false
+		} else {
+			isDutchIJ = false
+			isKeptChar := false
+			class := charIsKeptMark
+			if isDigit(ch, opts.Unicode) {
+				isKeptChar = true
+				class = charIsDigit
+			} else if len(opts.Separators) > 0 {
+				if !strings.ContainsRune(opts.Separators, ch) {
+					isKeptChar = true
+				}
+			} else if len(opts.Keep) > 0 {
+				if strings.ContainsRune(opts.Keep, ch) {
+					isKeptChar = true
+				}
+			}
+
+			if isKeptChar {
+				kind = TokenKeptMark
+				if class == charIsDigit {
+					kind = TokenDigits
+				}
+				wordStart = flag == ChIsFirstOfStr || flag == ChIsNextOfSepMark ||
+					opts.isWordBoundary(prevClass, class)
+				flag = ChIsNextOfKeptMark
+				prevClass = class
+			} else {
+				kind = TokenSeparator
+				if flag != ChIsFirstOfStr {
+					flag = ChIsNextOfSepMark
+				}
+			}
+		}
+
+		tokens = appendToken(tokens, kind, i, end, wordStart)
+		i = end
+	}
+
+	return tokens
+}
+
+// splitLastToken places a word boundary at the byte offset pos in the last token, by splitting
+// the token there unless it starts there.
+func splitLastToken(tokens []Token, pos int) []Token {
+	last := &tokens[len(tokens)-1]
+	if last.Start == pos {
+		last.WordStart = true
+		return tokens
+	}
+	token := Token{Kind: last.Kind, Start: pos, End: last.End, WordStart: true}
+	last.End = pos
+	return append(tokens, token)
+}
+
+// uppercaseAcronym converts the last word in result, which starts at wordStart and is converted
+// from word, to uppercase entirely if it is rendered in all caps according to opts.Acronyms and
+// opts.AcronymStyle. If only the word without its plural suffix "s" is rendered in all caps, the
+// suffix is left lowercase, like "IDs". The spans of the converted word are recorded into r again.
+func uppercaseAcronym(
+	result []byte, wordStart int, word string, opts *Options, r *spanRecorder,
+) []byte {
+	if !opts.hasAcronymRules() {
+		return result
+	}
+
+	hasUpper, hasLower := false, false
+	for i := 0; i < len(word); {
+		ch, size := utf8.DecodeRuneInString(word[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(word[i:])
+		}
+		hasUpper = hasUpper || isUpperCase(ch, opts.Unicode)
+		hasLower = hasLower || isLowerCase(ch, opts.Unicode)
+		i = end
+	}
+	if opts.isAllCapsWord(word, hasUpper && !hasLower) {
+		sepStart, sepEnd := r.holdSeparators()
+		pos := r.truncateWord(len(word))
+		result = appendUpperCase(result[:wordStart], word, pos, opts, r)
+		r.restoreSeparators(sepStart, sepEnd)
+		return result
+	}
+	if stem, ok := pluralStem(word); ok && opts.isAllCapsWord(stem, true) {
+		sepStart, sepEnd := r.holdSeparators()
+		pos := r.truncateWord(len(word))
+		result = append(appendUpperCase(result[:wordStart], stem, pos, opts, r), 's')
+		r.add(len(result), pos+len(stem), pos+len(word))
+		r.restoreSeparators(sepStart, sepEnd)
+		return result
+	}
+	return result
+}
+
+// appendUpperCase appends the word, which is at pos in the input string, in uppercase, and records
+// the spans of its units into r.
+func appendUpperCase(result []byte, word string, pos int, opts *Options, r *spanRecorder) []byte {
+	locale := opts.locale()
+	for i := 0; i < len(word); {
+		ch, size := utf8.DecodeRuneInString(word[i:])
+		end := i + size
+		if opts.Unicode {
+			end = i + graphemeClusterLen(word[i:])
+		}
+		if isUpperCase(ch, opts.Unicode) || isLowerCase(ch, opts.Unicode) {
+			result = appendCasedLetter(result, ch, word[i+size:end], mapToUpper, locale)
+		} else {
+			result = append(result, word[i:end]...)
+		}
+		r.add(len(result), pos+i, pos+end)
+		i = end
+	}
+	return result
+}
+
+// holdSeparators removes the pending separators from r, which follow the last word, while the
+// spans of the word are recorded again, and returns their range in the input string.
+func (r *spanRecorder) holdSeparators() (int, int) {
+	if r == nil {
+		return 0, 0
+	}
+	start, end := r.sepStart, r.sepEnd
+	r.sepStart, r.sepEnd = 0, 0
+	return start, end
+}
+
+// restoreSeparators makes the separators at the range in the input string pending in r again.
+func (r *spanRecorder) restoreSeparators(start, end int) {
+	if r != nil {
+		r.sepStart, r.sepEnd = start, end
+	}
+}
+
+// truncate removes the spans of the units at pos and after it, when the result is truncated to
+// re-append them.
+func (r *spanRecorder) truncate(pos int) {
+	if r == nil {
+		return
+	}
+	n := len(r.spans)
+	for n > 0 && r.spans[n-1].InStart >= pos && r.spans[n-1].InEnd > pos {
+		n--
+	}
+	r.spans = r.spans[:n]
+	r.outEnd = 0
+	if n > 0 {
+		r.outEnd = r.spans[n-1].OutEnd
+	}
+}
+
+// truncateWord removes the spans of the last word whose length in the input string is n, and
+// returns the position of the word in the input string.
+func (r *spanRecorder) truncateWord(n int) int {
+	if r == nil || len(r.spans) == 0 {
+		return 0
+	}
+	pos := r.spans[len(r.spans)-1].InEnd - n
+	r.truncate(pos)
+	return pos
+}
+
+// nextScript returns the script which the letter continues, and whether the script of the letter
+// differs from that of the preceding letter, in which case a word boundary is placed before it.
+func nextScript(prev *unicode.RangeTable, ch rune) (*unicode.RangeTable, bool) {
+	script := scriptOf(ch)
+	if script == nil {
+		return prev, false
+	}
+	return script, prev != nil && script != prev
+}
+
+func legacyWords(input string, opts Options) []string {
+	input = opts.preprocess(input)
+	words := make([]string, 0, 4)
+	wordStart, wordEnd := -1, -1
+	for _, token := range legacyAppendTokens(make([]Token, 0, 8), input, &opts) {
+		if token.Kind == TokenSeparator {
+			continue
+		}
+		if token.WordStart {
+			if wordStart >= 0 {
+				words = append(words, input[wordStart:wordEnd])
+			}
+			wordStart = token.Start
+		}
+		wordEnd = token.End
+	}
+	if wordStart >= 0 {
+		words = append(words, input[wordStart:wordEnd])
+	}
+	return words
+}
+
+func legacyWithMapping(
+	input string, opts Options, convert func(string, Options, *spanRecorder) []byte,
+) (string, []Span) {
+	input, offsets := opts.preprocessWithOffsets(input)
+	r := newSpanRecorder(len(input))
+	return r.finish(convert(input, opts, r), offsets)
+}
+
+var legacyCorpusPieces = []string{
+	"a", "b", "s", "x", "A", "B", "I", "J", "S", "X", "i", "j", "ij", "IJ", "iJ", "Ij",
+	"XML", "HTTP", "Http", "Ids", "IDs", "APIs", "api", "ID", "id", "Request", "user", "s1",
+	"0", "1", "12", "_", "-", " ", "__", ".", "#", "%", "+", "$",
+	"Σ", "σ", "ΟΔΟΣ", "α", "ΑΣ",
+	"İ", "ı", "ß", "Æ", "é", "é", "É", "́",
+	"か", "カ", "ー", "漢", "한", "٣", "ǅ", "ﬁ", "①",
+	"\U0001f44d\U0001f3fd", "\U0001f1ef\U0001f1f5", "i̇́", "\u0316", "\u1100", "\u1161", "\uff76\uff9e",
+	"\u0b47", "\u0b3e",
+	"OAuth2", "oauth2", "iOS", "IOS", "ios", "C++", "c++", "c_d", "k8s", "GraphQL",
+}
+
+var legacyCorpusProtected = [][]string{
+	nil,
+	{"OAuth2", "iOS", "C++", "k8s"},
+	{"GraphQL", "C_D", "XML", "IJ", "ΟΔΟΣ"},
+}
+
+var legacyCorpusAcronyms = [][]string{
+	nil,
+	{"XML", "HTTP", "ID", "API", "ΑΣ"},
+	{"IJ", "IDS", "s1"},
+}
+
+func legacyCorpusOptions(rnd *rand.Rand) Options {
+	boundaries := []Boundary{
+		0, BoundaryNone, BoundaryLowerUpper | BoundaryAcronym, BoundaryLowerUpper,
+		BoundaryLetterDigit | BoundaryDigitLetter | BoundarySymbol, BoundaryAcronym,
+	}
+	separators := []string{"", "", "-", "_ "}
+	keep := []string{"", "", "#%", ".+"}
+	isUnicode := rnd.Intn(3) != 0
+	return Options{
+		SeparateBeforeNonAlphabets: rnd.Intn(2) == 0,
+		SeparateAfterNonAlphabets:  rnd.Intn(2) == 0,
+		Separators:                 separators[rnd.Intn(len(separators))],
+		Keep:                       keep[rnd.Intn(len(keep))],
+		Boundaries:                 boundaries[rnd.Intn(len(boundaries))],
+		Unicode:                    isUnicode,
+		Locale:                     Locale(rnd.Intn(5)),
+		Normalization:              Normalization(rnd.Intn(4)),
+		FoldToASCII:                rnd.Intn(4) == 0,
+		SeparateScripts:            isUnicode && rnd.Intn(2) == 0,
+		Acronyms:                   NewWordList(legacyCorpusAcronyms[rnd.Intn(len(legacyCorpusAcronyms))]...),
+		AcronymStyle:               AcronymStyle(rnd.Intn(4)),
+		AcronymPlurals:             rnd.Intn(2) == 0,
+		ProtectedWords:             NewWordList(legacyCorpusProtected[rnd.Intn(len(legacyCorpusProtected))]...),
+	}
+}
+
+// TestSameResultsAsLegacyEngines checks that the conversion functions built on wordScanner
+// return the same results and spans as the separate engines which they replaced, and that Words
+// and Tokenize split strings in the same way as before. It also checks that each segment of an
+// offsetMap is preprocessed to the same string as in the whole input string.
+func TestSameResultsAsLegacyEngines(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 20000; n++ {
+		var b strings.Builder
+		for k := rnd.Intn(8); k >= 0; k-- {
+			b.WriteString(legacyCorpusPieces[rnd.Intn(len(legacyCorpusPieces))])
+		}
+		input := b.String()
+		opts := legacyCorpusOptions(rnd)
+		joiner := []rune{'_', '-', ' ', '·'}[rnd.Intn(4)]
+		msg := fmt.Sprintf("%q %+v", input, opts)
+
+		assert.Equal(t, Capitalize(input, joiner, opts),
+			string(legacyCapitalize(opts.preprocess(input), joiner, opts, nil)), msg)
+		assert.Equal(t, Lowerize(input, joiner, opts),
+			string(legacyLowerize(opts.preprocess(input), joiner, opts, nil)), msg)
+		assert.Equal(t, Upperize(input, joiner, opts),
+			string(legacyUpperize(opts.preprocess(input), joiner, opts, nil)), msg)
+		assert.Equal(t, CamelCaseWithOptions(input, opts),
+			string(legacyCamelCase(opts.preprocess(input), opts, nil)), msg)
+		assert.Equal(t, PascalCaseWithOptions(input, opts),
+			string(legacyPascalCase(opts.preprocess(input), opts, nil)), msg)
+
+		for _, c := range []struct {
+			convert func(string, Options) (string, []Span)
+			legacy  func(string, Options, *spanRecorder) []byte
+		}{
+			{func(s string, o Options) (string, []Span) {
+				return CapitalizeWithMapping(s, joiner, o)
+			}, func(s string, o Options, r *spanRecorder) []byte {
+				return legacyCapitalize(s, joiner, o, r)
+			}},
+			{func(s string, o Options) (string, []Span) {
+				return LowerizeWithMapping(s, joiner, o)
+			}, func(s string, o Options, r *spanRecorder) []byte {
+				return legacyLowerize(s, joiner, o, r)
+			}},
+			{func(s string, o Options) (string, []Span) {
+				return UpperizeWithMapping(s, joiner, o)
+			}, func(s string, o Options, r *spanRecorder) []byte {
+				return legacyUpperize(s, joiner, o, r)
+			}},
+			{CamelCaseWithMapping, legacyCamelCase},
+			{PascalCaseWithMapping, legacyPascalCase},
+		} {
+			result, spans := c.convert(input, opts)
+			legacyResult, legacySpans := legacyWithMapping(input, opts, c.legacy)
+			assert.Equal(t, result, legacyResult, msg)
+			assert.Equal(t, spans, legacySpans, msg)
+		}
+
+		assert.Equal(t, Words(input, opts), legacyWords(input, opts), msg)
+		preprocessed, offsets := opts.preprocessWithOffsets(input)
+		for k := 1; k < len(offsets.out); k++ {
+			assert.Equal(t, preprocessed[offsets.out[k-1]:offsets.out[k]],
+				opts.preprocess(input[offsets.in[k-1]:offsets.in[k]]), msg)
+		}
+		assert.Equal(t, appendTokens(nil, preprocessed, &opts),
+			legacyAppendTokens(nil, preprocessed, &opts), msg)
+
+		if t.Failed() {
+			return
+		}
+	}
+}
+
+// TestSameResultsWithoutAsciiFastPath checks that appendAsciiWords returns the same results as
+// wordScanner for ASCII strings. appendWords is called with a spanRecorder to disable the fast
+// path.
+func TestSameResultsWithoutAsciiFastPath(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	var pieces []string
+	for _, piece := range legacyCorpusPieces {
+		if isPlainAscii(piece, &Options{}) {
+			pieces = append(pieces, piece)
+		}
+	}
+	pieces = append(pieces, "\r\n", "\r", "\n")
+	wordCases := []WordCase{WordCaseLower, WordCaseUpper, WordCaseTitle}
+
+	for n := 0; n < 20000; n++ {
+		var b strings.Builder
+		for k := rnd.Intn(8); k >= 0; k-- {
+			b.WriteString(pieces[rnd.Intn(len(pieces))])
+		}
+		input := b.String()
+		opts := legacyCorpusOptions(rnd)
+		opts.Normalization, opts.FoldToASCII = NormalizationNone, false
+		opts.Acronyms, opts.AcronymStyle, opts.AcronymPlurals = WordList{}, AcronymStyleDefault, false
+		opts.ProtectedWords = WordList{}
+		if rnd.Intn(2) == 0 {
+			opts.Locale = LocaleNone
+		}
+		if rnd.Intn(4) == 0 {
+			opts.Separators, opts.Keep = "", "\r"
+		}
+		first, rest := wordCases[rnd.Intn(3)], wordCases[rnd.Intn(3)]
+		joiner := []string{"", "_", "--"}[rnd.Intn(3)]
+		msg := fmt.Sprintf("%q %+v %d %d %q", input, opts, first, rest, joiner)
+
+		result := appendWords(nil, input, &opts, first, rest, joiner, nil, nil)
+		expected := appendWords(nil, input, &opts, first, rest, joiner, newSpanRecorder(0), nil)
+		assert.Equal(t, string(result), string(expected), msg)
+
+		if t.Failed() {
+			return
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale is a language whose casing rules differ from the default Unicode case mappings.
+// It is specified in the Locale field of Options and takes effect only when the Unicode field of
+// Options is true.
+type Locale uint8
+
+const (
+	// LocaleNone applies the default Unicode case mappings.
+	LocaleNone Locale = iota
+
+	// LocaleTurkish maps i to İ and I to ı, and İ to i.
+	LocaleTurkish
+
+	// LocaleAzeri applies the same rules as LocaleTurkish.
+	LocaleAzeri
+
+	// LocaleLithuanian keeps the dot of i and j when they are lowercased with an accent above, so
+	// Ì, Í and Ĩ are lowercased to i̇̀, i̇́ and i̇̃.
+	LocaleLithuanian
+
+	// LocaleDutch treats IJ at the beginning of a word as a single letter, so it is never split
+	// into two words and is capitalized together, like "IJsland".
+	LocaleDutch
+)
+
+const (
+	combiningDotAbove rune = 0x0307
+)
+
+// combiningAbove is the combining diacritical marks which are placed above a base letter
+// (canonical combining class 230) in the Combining Diacritical Marks block.
+var combiningAbove = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0300, Hi: 0x0314, Stride: 1},
+		{Lo: 0x033d, Hi: 0x0344, Stride: 1},
+		{Lo: 0x0346, Hi: 0x0346, Stride: 1},
+		{Lo: 0x034a, Hi: 0x034c, Stride: 1},
+		{Lo: 0x0350, Hi: 0x0352, Stride: 1},
+		{Lo: 0x0357, Hi: 0x0357, Stride: 1},
+		{Lo: 0x035b, Hi: 0x035b, Stride: 1},
+		{Lo: 0x0363, Hi: 0x036f, Stride: 1},
+	},
+}
+
+// nextMarkAbove returns the first combining mark placed above a base letter among the combining
+// marks at the head of the string, or 0 if there is not such a mark.
+func nextMarkAbove(s string) rune {
+	for _, r := range s {
+		if unicode.Is(combiningAbove, r) {
+			return r
+		}
+		if !unicode.Is(unicode.Mn, r) {
+			break
+		}
+	}
+	return 0
+}
+
+func (opts *Options) locale() Locale {
+	if !opts.isUnicode() {
+		return LocaleNone
+	}
+	return opts.Locale
+}
+
+// appendLocaleCase appends the case-mapped letter to result according to the rules of the locale.
+// The combining marks following the letter are used to evaluate the context of the rules. If the
+// locale has no rule for the letter, this function returns false.
+func appendLocaleCase(
+	result []byte, ch rune, m caseMapping, marks string, locale Locale,
+) ([]byte, bool) {
+	switch locale {
+	case LocaleTurkish, LocaleAzeri:
+		switch m {
+		case mapToLower:
+			if ch == 'I' {
+				if nextMarkAbove(marks) == combiningDotAbove {
+					return append(result, 'i'), true
+				}
+				return utf8.AppendRune(result, 'ı'), true
+			} else if ch == 'İ' {
+				return append(result, 'i'), true
+			}
+		default:
+			if ch == 'i' {
+				return utf8.AppendRune(result, 'İ'), true
+			}
+		}
+	case LocaleLithuanian:
+		if m == mapToLower {
+			switch ch {
+			case 'I', 'J', 'Į':
+				if nextMarkAbove(marks) != 0 {
+					result = utf8.AppendRune(result, unicode.ToLower(ch))
+					return utf8.AppendRune(result, combiningDotAbove), true
+				}
+			case 'Ì':
+				return append(result, "i\u0307\u0300"...), true
+			case 'Í':
+				return append(result, "i\u0307\u0301"...), true
+			case 'Ĩ':
+				return append(result, "i\u0307\u0303"...), true
+			}
+		}
+	}
+	return result, false
+}
+
+// isDotAboveRemoved reports whether the combining dot above in the marks following the letter is
+// removed by the case mapping. In Turkish and Azeri, it is removed when I with it is lowercased
+// to i, and in Lithuanian, when a soft-dotted letter with it is uppercased or titlecased.
+func isDotAboveRemoved(ch rune, m caseMapping, marks string, locale Locale) bool {
+	switch locale {
+	case LocaleTurkish, LocaleAzeri:
+		if m != mapToLower || ch != 'I' {
+			return false
+		}
+	case LocaleLithuanian:
+		if m == mapToLower || !unicode.Is(unicode.Soft_Dotted, ch) {
+			return false
+		}
+	default:
+		return false
+	}
+	return nextMarkAbove(marks) == combiningDotAbove
+}
+
+func isDutchI(ch rune) bool {
+	return ch == 'I' || ch == 'i'
+}
+
+func isDutchJ(ch rune) bool {
+	return ch == 'J' || ch == 'j'
+}
@@ -0,0 +1,145 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestLocale(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Unicode:                    true,
+	}
+
+	t.Run("Turkish", func(t *testing.T) {
+		opts := origOpts
+		opts.Locale = stringcase.LocaleTurkish
+
+		result := stringcase.PascalCaseWithOptions("istanbul_izmir", opts)
+		assert.Equal(t, result, "İstanbulİzmir")
+
+		result = stringcase.MacroCaseWithOptions("istanbulIzmir", opts)
+		assert.Equal(t, result, "İSTANBUL_IZMİR")
+
+		result = stringcase.SnakeCaseWithOptions("DİYARBAKIR", opts)
+		assert.Equal(t, result, "diyarbakır")
+
+		result = stringcase.SnakeCaseWithOptions("ISPARTA", opts)
+		assert.Equal(t, result, "ısparta")
+
+		result = stringcase.TitleCaseWithOptions("ISPARTA_isparta", opts)
+		assert.Equal(t, result, "Isparta İsparta")
+
+		result = stringcase.SnakeCaseWithOptions("İzmir", opts)
+		assert.Equal(t, result, "izmir")
+
+		result = stringcase.SnakeCaseWithOptions("I\u0307zmir", opts)
+		assert.Equal(t, result, "izmir")
+
+		result = stringcase.MacroCaseWithOptions("I\u0301zmir", opts)
+		assert.Equal(t, result, "I\u0301ZMİR")
+	})
+
+	t.Run("Azeri", func(t *testing.T) {
+		opts := origOpts
+		opts.Locale = stringcase.LocaleAzeri
+
+		result := stringcase.CamelCaseWithOptions("BAKI_ismayilli", opts)
+		assert.Equal(t, result, "bakıİsmayilli")
+
+		result = stringcase.CobolCaseWithOptions("bakı_ismayilli", opts)
+		assert.Equal(t, result, "BAKI-İSMAYİLLİ")
+	})
+
+	t.Run("Lithuanian", func(t *testing.T) {
+		opts := origOpts
+		opts.Locale = stringcase.LocaleLithuanian
+
+		result := stringcase.SnakeCaseWithOptions("ÌKIS", opts)
+		assert.Equal(t, result, "i\u0307\u0300kis")
+
+		result = stringcase.KebabCaseWithOptions("ÍĨ", opts)
+		assert.Equal(t, result, "i\u0307\u0301i\u0307\u0303")
+
+		result = stringcase.PascalCaseWithOptions("ìkis", opts)
+		assert.Equal(t, result, "Ìkis")
+
+		result = stringcase.SnakeCaseWithOptions("I\u0300", opts)
+		assert.Equal(t, result, "i\u0307\u0300")
+
+		result = stringcase.MacroCaseWithOptions("i\u0307\u0300kis", opts)
+		assert.Equal(t, result, "I\u0300KIS")
+
+		result = stringcase.PascalCaseWithOptions("j\u0307\u0301_i\u0307", opts)
+		assert.Equal(t, result, "J\u0301I")
+
+		result = stringcase.SnakeCaseWithOptions("IJĮ", opts)
+		assert.Equal(t, result, "ijį")
+
+		result = stringcase.SnakeCaseWithOptions("I\u0316\u0301A\u0301", opts)
+		assert.Equal(t, result, "i\u0307\u0316\u0301a\u0301")
+
+		result = stringcase.SnakeCaseWithOptions("I\u0903", opts)
+		assert.Equal(t, result, "i\u0903")
+	})
+
+	t.Run("Dutch", func(t *testing.T) {
+		opts := origOpts
+		opts.Locale = stringcase.LocaleDutch
+
+		result := stringcase.PascalCaseWithOptions("ijsland_ijmuiden", opts)
+		assert.Equal(t, result, "IJslandIJmuiden")
+
+		result = stringcase.TitleCaseWithOptions("ijsland", opts)
+		assert.Equal(t, result, "IJsland")
+
+		result = stringcase.SnakeCaseWithOptions("IJslandIJmuiden", opts)
+		assert.Equal(t, result, "ijsland_ijmuiden")
+
+		result = stringcase.SnakeCaseWithOptions("IJsselE\u0301", opts)
+		assert.Equal(t, result, "ijssel_e\u0301")
+
+		result = stringcase.CamelCaseWithOptions("IJSLAND_IJMUIDEN", opts)
+		assert.Equal(t, result, "ijslandIJmuiden")
+
+		result = stringcase.TrainCaseWithOptions("bijna_iglo", opts)
+		assert.Equal(t, result, "Bijna-Iglo")
+
+		result = stringcase.PascalCaseWithOptions("iJssel_ABIjzer", opts)
+		assert.Equal(t, result, "IJsselAbIJzer")
+
+		result = stringcase.TrainCaseWithOptions("fooIjssel_iJzer_ABIjzer", opts)
+		assert.Equal(t, result, "Foo-IJssel-IJzer-Ab-IJzer")
+
+		result = stringcase.CamelCaseWithOptions("iJssel_fooIjzer_ABIjzer", opts)
+		assert.Equal(t, result, "ijsselFooIJzerAbIJzer")
+
+		result = stringcase.MacroCaseWithOptions("iJzer_ABIjzer", opts)
+		assert.Equal(t, result, "IJZER_AB_IJZER")
+	})
+
+	t.Run("no effect without the Unicode option", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = false
+		opts.Locale = stringcase.LocaleTurkish
+
+		result := stringcase.PascalCaseWithOptions("istanbul_izmir", opts)
+		assert.Equal(t, result, "IstanbulIzmir")
+
+		opts.Locale = stringcase.LocaleDutch
+		result = stringcase.SnakeCaseWithOptions("IJsland", opts)
+		assert.Equal(t, result, "i_jsland")
+	})
+
+	t.Run("default Unicode case mappings", func(t *testing.T) {
+		result := stringcase.PascalCaseWithOptions("istanbul_ijsland", origOpts)
+		assert.Equal(t, result, "IstanbulIjsland")
+
+		result = stringcase.SnakeCaseWithOptions("ÌKIS", origOpts)
+		assert.Equal(t, result, "ìkis")
+	})
+}
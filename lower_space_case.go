@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// LowerSpaceCaseWithOptions converts the input string to lower space case with the
+// specified options.
+func LowerSpaceCaseWithOptions(input string, opts Options) string {
+	return Format(input, styleLowerSpace, opts)
+}
+
+// LowerSpaceCaseWithMapping converts the input string to lower space case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func LowerSpaceCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleLowerSpace, opts)
+}
+
+// LowerSpaceCase converts the input string to lower space case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func LowerSpaceCase(input string) string {
+	return Format(input, styleLowerSpace, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendLowerSpaceCase appends the input string converted to lower space case to dst
+// and returns the extended buffer, in the same way as LowerSpaceCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendLowerSpaceCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleLowerSpace, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsLowerSpaceCaseWithOptions reports whether the input string is in lower space case
+// with the specified options, that is, whether LowerSpaceCaseWithOptions
+// returns it unchanged.
+func IsLowerSpaceCaseWithOptions(input string, opts Options) bool {
+	return LowerSpaceCaseWithOptions(input, opts) == input
+}
+
+// IsLowerSpaceCase reports whether the input string is in lower space case.
+func IsLowerSpaceCase(input string) bool {
+	return LowerSpaceCase(input) == input
+}
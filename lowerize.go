@@ -5,7 +5,7 @@
 package stringcase
 
 import (
-	"strings"
+	"unicode/utf8"
 )
 
 // Lowerize converts all ASCII alphabetic characters in the input string to lowercase, inserting the
@@ -24,90 +24,42 @@ import (
 // other non-alphanumeric characters are removed. If neither is specified, all non-alphanumeric
 // characters are treated as separators and removed. The fields opts.SeparateBeforeNonAlphabets
 // and opts.SeparateAfterNonAlphabets further determine whether word boundaries are inserted before
-// or after non-alphabetic sequences.
+// or after non-alphabetic sequences, unless opts.Boundaries specifies each kind of word boundaries
+// individually. If opts.Normalization is specified, the input string is normalized to the Unicode
+// normalization form, and if opts.FoldToASCII is true, Latin letters are replaced with their ASCII
+// equivalents, before these rules are applied.
 //
 // This function never returns an error or panics on any input, returning an empty string when the
 // input is empty. Casing transformations and word boundary detections apply strictly to ASCII
-// letters, treating non-ASCII characters as non-alphanumeric. If both opts.Separators and
-// opts.Keep are specified, opts.Separators takes precedence and opts.Keep is ignored, while any
-// alphanumeric characters listed in either field are disregarded. Additionally, leading and
-// trailing separator characters are trimmed from the result without producing leading or trailing
-// joiners.
+// letters, treating non-ASCII characters as non-alphanumeric, unless opts.Unicode is true, in which
+// case Unicode letters and decimal digits are classified and case-mapped by their Unicode
+// categories. If both opts.Separators and opts.Keep are specified, opts.Separators takes precedence
+// and opts.Keep is ignored, while any alphanumeric characters listed in either field are
+// disregarded. Additionally, leading and trailing separator characters are trimmed from the result
+// without producing leading or trailing joiners.
 func Lowerize(input string, joiner rune, opts Options) string {
-	result := make([]rune, 0, len(input)+len(input)/2)
-
-	const (
-		ChIsFirstOfStr = iota
-		ChIsNextOfUpper
-		ChIsNextOfContdUpper
-		ChIsNextOfSepMark
-		ChIsNextOfKeptMark
-		ChIsOther
-	)
-	var flag uint8 = ChIsFirstOfStr
-
-	for _, ch := range input {
-		if isAsciiUpperCase(ch) {
-			if flag == ChIsFirstOfStr {
-				result = append(result, toAsciiLowerCase(ch))
-				flag = ChIsNextOfUpper
-			} else if flag == ChIsNextOfUpper || flag == ChIsNextOfContdUpper ||
-				(!opts.SeparateAfterNonAlphabets && flag == ChIsNextOfKeptMark) {
-				result = append(result, toAsciiLowerCase(ch))
-				flag = ChIsNextOfContdUpper
-			} else {
-				result = append(result, joiner, toAsciiLowerCase(ch))
-				flag = ChIsNextOfUpper
-			}
-		} else if isAsciiLowerCase(ch) {
-			if flag == ChIsNextOfContdUpper {
-				n := len(result)
-				prev := result[n-1]
-				result[n-1] = joiner
-				result = append(result, prev, ch)
-			} else if flag == ChIsNextOfSepMark ||
-				(opts.SeparateAfterNonAlphabets && flag == ChIsNextOfKeptMark) {
-				result = append(result, joiner, ch)
-			} else {
-				result = append(result, ch)
-			}
-			flag = ChIsOther
-		} else {
-			isKeptChar := false
-			if isAsciiDigit(ch) {
-				isKeptChar = true
-			} else if len(opts.Separators) > 0 {
-				if !strings.ContainsRune(opts.Separators, ch) {
-					isKeptChar = true
-				}
-			} else if len(opts.Keep) > 0 {
-				if strings.ContainsRune(opts.Keep, ch) {
-					isKeptChar = true
-				}
-			}
+	style := Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: string(joiner)}
+	return Format(input, style, opts)
+}
 
-			if isKeptChar {
-				if opts.SeparateBeforeNonAlphabets {
-					if flag == ChIsFirstOfStr || flag == ChIsNextOfKeptMark {
-						result = append(result, ch)
-					} else {
-						result = append(result, joiner, ch)
-					}
-				} else {
-					if flag != ChIsNextOfSepMark {
-						result = append(result, ch)
-					} else {
-						result = append(result, joiner, ch)
-					}
-				}
-				flag = ChIsNextOfKeptMark
-			} else {
-				if flag != ChIsFirstOfStr {
-					flag = ChIsNextOfSepMark
-				}
-			}
-		}
-	}
+// AppendLowerize appends the input string converted in the same way as Lowerize to dst and
+// returns the extended buffer. Like strconv.AppendInt, it does not allocate memory when dst has
+// enough capacity, unless opts.Normalization or opts.FoldToASCII changes the input string.
+func AppendLowerize(dst []byte, input string, joiner rune, opts Options) []byte {
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], joiner)
+	input = opts.preprocess(input)
+	style := Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: string(buf[:n])}
+	return appendStyle(dst, input, &style, &opts, nil)
+}
 
-	return string(result)
+// LowerizeWithMapping converts the input string in the same way as Lowerize, and also returns the
+// spans which map each byte range of the result to the byte range of the input string it came
+// from. The spans cover both the result and the input string in order without gaps or overlaps,
+// including the joiners inserted between words and the separators removed from the input string.
+// If opts.Normalization or opts.FoldToASCII is specified, the spans are mapped back to the
+// original input string, and the spans whose input ranges overlap are merged.
+func LowerizeWithMapping(input string, joiner rune, opts Options) (string, []Span) {
+	style := Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: string(joiner)}
+	return FormatWithMapping(input, style, opts)
 }
@@ -1665,3 +1665,63 @@ func TestLowerize(t *testing.T) {
 		})
 	})
 }
+
+func TestLowerizeWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.LowerizeWithMapping("-aB_c1-", '.', opts)
+		assert.Equal(t, result, "a.b.c1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.LowerizeWithMapping("", '.', opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestAppendLowerize(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendLowerize([]byte("key="), "userAccount-settings", '.', opts)
+		assert.Equal(t, string(buf), "key=user.account.settings")
+	})
+
+	t.Run("same result as Lowerize", func(t *testing.T) {
+		inputs := []string{"", "  fooBar100%baz. hello__World!", "XMLHttpRequest"}
+		joiners := []rune{'_', '\u30fb', '\U0001f600'}
+		for _, input := range inputs {
+			for _, joiner := range joiners {
+				buf := stringcase.AppendLowerize(nil, input, joiner, opts)
+				assert.Equal(t, string(buf), stringcase.Lowerize(input, joiner, opts))
+			}
+		}
+
+		nfd := stringcase.Options{Unicode: true, Normalization: stringcase.NormalizationNFD, FoldToASCII: true}
+		buf := stringcase.AppendLowerize(nil, "Cr\u00e8me Br\u00fbl\u00e9e", '-', nfd)
+		assert.Equal(t, string(buf), stringcase.Lowerize("Cr\u00e8me Br\u00fbl\u00e9e", '-', nfd))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendLowerize(buf[:0], "userAccount-settings. fooBar100%baz", '\u30fb', opts)
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
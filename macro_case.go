@@ -7,7 +7,14 @@ package stringcase
 // MacroCaseWithOptions converts the input string to macro case with the
 // specified options.
 func MacroCaseWithOptions(input string, opts Options) string {
-	return Upperize(input, '_', opts)
+	return Format(input, styleMacro, opts)
+}
+
+// MacroCaseWithMapping converts the input string to macro case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func MacroCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleMacro, opts)
 }
 
 // MacroCase converts the input string to macro case.
@@ -15,8 +22,30 @@ func MacroCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func MacroCase(input string) string {
-	return Upperize(input, '_', Options{
+	return Format(input, styleMacro, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// AppendMacroCase appends the input string converted to macro case to dst
+// and returns the extended buffer, in the same way as MacroCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendMacroCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleMacro, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsMacroCaseWithOptions reports whether the input string is in macro case
+// with the specified options, that is, whether MacroCaseWithOptions
+// returns it unchanged.
+func IsMacroCaseWithOptions(input string, opts Options) bool {
+	return MacroCaseWithOptions(input, opts) == input
+}
+
+// IsMacroCase reports whether the input string is in macro case.
+func IsMacroCase(input string) bool {
+	return MacroCase(input) == input
+}
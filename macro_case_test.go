@@ -1256,3 +1256,82 @@ func TestMacroCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestMacroCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.MacroCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "A_B_C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.MacroCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsMacroCase(t *testing.T) {
+	t.Run("string in macro case", func(t *testing.T) {
+		assert.True(t, stringcase.IsMacroCase("USER_ACCOUNT_SETTINGS"))
+		assert.True(t, stringcase.IsMacroCase(""))
+	})
+
+	t.Run("string not in macro case", func(t *testing.T) {
+		assert.False(t, stringcase.IsMacroCase("user_account_settings"))
+		assert.False(t, stringcase.IsMacroCase("_USER_ACCOUNT_SETTINGS"))
+		assert.False(t, stringcase.IsMacroCase("RATE%_LIMIT"))
+	})
+}
+
+func TestIsMacroCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in macro case", func(t *testing.T) {
+		assert.True(t, stringcase.IsMacroCaseWithOptions("RATE%_LIMIT", opts))
+		assert.True(t, stringcase.IsMacroCaseWithOptions("USER_ACCOUNT_SETTINGS", opts))
+	})
+
+	t.Run("string not in macro case", func(t *testing.T) {
+		assert.False(t, stringcase.IsMacroCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsMacroCaseWithOptions("_USER_ACCOUNT_SETTINGS", opts))
+	})
+}
+
+func TestAppendMacroCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendMacroCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=USER_ACCOUNT_SETTINGS")
+
+		buf = stringcase.AppendMacroCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "USER_ACCOUNT_SETTINGS")
+	})
+
+	t.Run("same result as MacroCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendMacroCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.MacroCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendMacroCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
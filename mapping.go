@@ -0,0 +1,102 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// Span is a pair of a byte range in a converted string and the byte range in the input string
+// which the converted bytes came from.
+//
+// A joiner inserted between words is mapped to the separators which it replaces, or to the empty
+// range at the word boundary if there are no such separators. Separators removed without a joiner
+// are mapped from the empty range at the position where they would have been in the converted
+// string.
+type Span struct {
+	OutStart int
+	OutEnd   int
+	InStart  int
+	InEnd    int
+}
+
+// spanRecorder records the spans which map a converted string to the input string while a
+// conversion function appends bytes to its result. Until finish is called, InStart and InEnd of
+// the spans are the byte offsets in the preprocessed input string. All methods do nothing on a nil
+// spanRecorder.
+type spanRecorder struct {
+	spans    []Span
+	outEnd   int
+	sepStart int
+	sepEnd   int
+}
+
+func newSpanRecorder(n int) *spanRecorder {
+	return &spanRecorder{spans: make([]Span, 0, n+1)}
+}
+
+// add records the unit at input[start:end], which is converted to the bytes of the result from
+// the end of the last span up to outEnd. A unit converted to nothing is a separator, and is kept
+// pending until it is recorded together with the joiner or the unit after it.
+func (r *spanRecorder) add(outEnd, start, end int) {
+	if r != nil {
+		r.record(outEnd, start, end)
+	}
+}
+
+func (r *spanRecorder) record(outEnd, start, end int) {
+	if outEnd == r.outEnd {
+		if r.sepEnd == r.sepStart {
+			r.sepStart = start
+		}
+		r.sepEnd = end
+		return
+	}
+	r.flushSeparators()
+	r.spans = append(r.spans, Span{OutStart: r.outEnd, OutEnd: outEnd, InStart: start, InEnd: end})
+	r.outEnd = outEnd
+}
+
+// join records the joiner which ends at outEnd in the result and is inserted before the word
+// starting at input[pos:]. The joiner is mapped to the separators just before the word if any.
+func (r *spanRecorder) join(outEnd, pos int) {
+	if r == nil {
+		return
+	}
+	if r.sepEnd > r.sepStart {
+		r.flushSeparators()
+		r.spans[len(r.spans)-1].OutEnd = outEnd
+	} else if len(r.spans) > 0 {
+		span := Span{OutStart: r.outEnd, OutEnd: outEnd, InStart: pos, InEnd: pos}
+		r.spans = append(r.spans, span)
+	}
+	r.outEnd = outEnd
+}
+
+func (r *spanRecorder) flushSeparators() {
+	if r.sepEnd > r.sepStart {
+		r.spans = append(r.spans, Span{
+			OutStart: r.outEnd, OutEnd: r.outEnd, InStart: r.sepStart, InEnd: r.sepEnd,
+		})
+		r.sepStart, r.sepEnd = 0, 0
+	}
+}
+
+// finish returns the result as a string and the spans. The offsets in the input string are mapped
+// back with offsets, and the spans whose input ranges overlap are merged.
+func (r *spanRecorder) finish(result []byte, offsets offsetMap) (string, []Span) {
+	r.flushSeparators()
+
+	spans := make([]Span, 0, len(r.spans))
+	for _, span := range r.spans {
+		span.InStart, span.InEnd = offsets.start(span.InStart), offsets.end(span.InEnd)
+		if k := len(spans); k > 0 && span.InStart < spans[k-1].InEnd {
+			last := &spans[k-1]
+			last.OutEnd = span.OutEnd
+			if span.InEnd > last.InEnd {
+				last.InEnd = span.InEnd
+			}
+			continue
+		}
+		spans = append(spans, span)
+	}
+	return string(result), spans
+}
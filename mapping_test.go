@@ -0,0 +1,152 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestMapping(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map joiners to the separators they replace", func(t *testing.T) {
+		result, spans := stringcase.KebabCaseWithMapping("foo__bar", origOpts)
+		assert.Equal(t, result, "foo-bar")
+		assert.Equal(t, spans[3], stringcase.Span{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 5})
+
+		result, spans = stringcase.SnakeCaseWithMapping("fooBar", origOpts)
+		assert.Equal(t, result, "foo_bar")
+		assert.Equal(t, spans[3], stringcase.Span{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 3})
+
+		result, spans = stringcase.SnakeCaseWithMapping("--", origOpts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 2}})
+	})
+
+	t.Run("map a protected word as a whole", func(t *testing.T) {
+		opts := origOpts
+		opts.ProtectedWords = stringcase.NewWordList("iOS")
+
+		result, spans := stringcase.PascalCaseWithMapping("ios_app", opts)
+		assert.Equal(t, result, "iOSApp")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 3, InStart: 0, InEnd: 3},
+			{OutStart: 3, OutEnd: 3, InStart: 3, InEnd: 4},
+			{OutStart: 3, OutEnd: 4, InStart: 4, InEnd: 5},
+			{OutStart: 4, OutEnd: 5, InStart: 5, InEnd: 6},
+			{OutStart: 5, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+
+		result, spans = stringcase.SnakeCaseWithMapping("IOSApp", opts)
+		assert.Equal(t, result, "ios_app")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 3, InStart: 0, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 3},
+			{OutStart: 4, OutEnd: 5, InStart: 3, InEnd: 4},
+			{OutStart: 5, OutEnd: 6, InStart: 4, InEnd: 5},
+			{OutStart: 6, OutEnd: 7, InStart: 5, InEnd: 6},
+		})
+	})
+
+	t.Run("map letters converted to multiple letters", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+
+		result, spans := stringcase.MacroCaseWithMapping("straße", opts)
+		assert.Equal(t, result, "STRASSE")
+		assert.Equal(t, spans[4], stringcase.Span{OutStart: 4, OutEnd: 6, InStart: 4, InEnd: 6})
+	})
+
+	t.Run("map letters converted again at word boundaries", func(t *testing.T) {
+		result, spans := stringcase.SnakeCaseWithMapping("HTTPServer", origOpts)
+		assert.Equal(t, result, "http_server")
+		assert.Equal(t, spans[3:6], []stringcase.Span{
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 4},
+			{OutStart: 5, OutEnd: 6, InStart: 4, InEnd: 5},
+		})
+
+		opts := origOpts
+		opts.Acronyms = stringcase.NewWordList("XML", "ID")
+		result, spans = stringcase.PascalCaseWithMapping("xml_IDs", opts)
+		assert.Equal(t, result, "XMLIDs")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 1, InStart: 0, InEnd: 1},
+			{OutStart: 1, OutEnd: 2, InStart: 1, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 3, InStart: 3, InEnd: 4},
+			{OutStart: 3, OutEnd: 4, InStart: 4, InEnd: 5},
+			{OutStart: 4, OutEnd: 5, InStart: 5, InEnd: 6},
+			{OutStart: 5, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("map back through the preprocessing", func(t *testing.T) {
+		opts := origOpts
+		opts.FoldToASCII = true
+
+		result, spans := stringcase.SnakeCaseWithMapping("Straße_xÆble", opts)
+		assert.Equal(t, result, "strasse_x_aeble")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 1, InStart: 0, InEnd: 1},
+			{OutStart: 1, OutEnd: 2, InStart: 1, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 7, InStart: 4, InEnd: 7},
+			{OutStart: 7, OutEnd: 8, InStart: 7, InEnd: 8},
+			{OutStart: 8, OutEnd: 9, InStart: 8, InEnd: 9},
+			{OutStart: 9, OutEnd: 10, InStart: 9, InEnd: 9},
+			{OutStart: 10, OutEnd: 13, InStart: 9, InEnd: 12},
+			{OutStart: 13, OutEnd: 14, InStart: 12, InEnd: 13},
+			{OutStart: 14, OutEnd: 15, InStart: 13, InEnd: 14},
+		})
+
+		result, spans = stringcase.MacroCaseWithMapping("Æ", opts)
+		assert.Equal(t, result, "AE")
+		assert.Equal(t, spans, []stringcase.Span{{OutStart: 0, OutEnd: 2, InStart: 0, InEnd: 2}})
+
+		opts.Unicode = true
+		opts.Keep = "\u0600"
+		result, spans = stringcase.SnakeCaseWithMapping("a\u06001b", opts)
+		assert.Equal(t, result, "a\u06001_b")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 1, InStart: 0, InEnd: 1},
+			{OutStart: 1, OutEnd: 4, InStart: 1, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 4},
+			{OutStart: 5, OutEnd: 6, InStart: 4, InEnd: 5},
+		})
+	})
+
+	t.Run("map back through the normalization of non-Latin letters", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.Normalization = stringcase.NormalizationNFC
+
+		result, spans := stringcase.SnakeCaseWithMapping("\u03ac\u03bb\u03c6\u03b1\u0392\u03ae", opts)
+		assert.Equal(t, result, "\u03ac\u03bb\u03c6\u03b1_\u03b2\u03ae")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 2, InStart: 0, InEnd: 2},
+			{OutStart: 2, OutEnd: 4, InStart: 2, InEnd: 4},
+			{OutStart: 4, OutEnd: 6, InStart: 4, InEnd: 6},
+			{OutStart: 6, OutEnd: 8, InStart: 6, InEnd: 8},
+			{OutStart: 8, OutEnd: 9, InStart: 8, InEnd: 8},
+			{OutStart: 9, OutEnd: 11, InStart: 8, InEnd: 10},
+			{OutStart: 11, OutEnd: 13, InStart: 10, InEnd: 12},
+		})
+
+		result, spans = stringcase.SnakeCaseWithMapping("\u03b1\u0301\u03bb-\u0393\u03b1\u0301", opts)
+		assert.Equal(t, result, "\u03ac\u03bb_\u03b3\u03ac")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 2, InStart: 0, InEnd: 4},
+			{OutStart: 2, OutEnd: 4, InStart: 4, InEnd: 6},
+			{OutStart: 4, OutEnd: 5, InStart: 6, InEnd: 7},
+			{OutStart: 5, OutEnd: 7, InStart: 7, InEnd: 9},
+			{OutStart: 7, OutEnd: 9, InStart: 9, InEnd: 13},
+		})
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Normalization is a Unicode normalization form applied to an input string before it is split
+// into words. It is specified in the Normalization field of Options.
+type Normalization uint8
+
+const (
+	// NormalizationNone does not normalize input strings.
+	NormalizationNone Normalization = iota
+
+	// NormalizationNFC composes characters by canonical equivalence, so "e" followed by a
+	// combining acute accent becomes "é".
+	NormalizationNFC
+
+	// NormalizationNFD decomposes characters by canonical equivalence, so "é" becomes "e" followed
+	// by a combining acute accent.
+	NormalizationNFD
+
+	// NormalizationNFKC decomposes characters by compatibility equivalence and composes them by
+	// canonical equivalence, so "①" becomes "1" and "ﬀ" becomes "ff".
+	NormalizationNFKC
+)
+
+// decomposition is the full decompositions of a character.
+type decomposition struct {
+	ch   rune
+	nfd  string
+	nfkd string
+}
+
+// combiningClass is a range of characters which have the same canonical combining class.
+type combiningClass struct {
+	lo  rune
+	hi  rune
+	ccc uint8
+}
+
+// composition is a pair of characters which is canonically composed to a character.
+type composition struct {
+	first    rune
+	second   rune
+	composed rune
+}
+
+const (
+	minDecomposition = 0xa0
+	minCombiningMark = 0x300
+)
+
+// normalize returns the input string normalized to the form. Since ASCII strings are not changed
+// by any normalization form, they are returned as they are.
+func normalize(input string, form Normalization) string {
+	if form == NormalizationNone || isAsciiString(input) {
+		return input
+	}
+
+	isCompat := form == NormalizationNFKC
+	buf := make([]rune, 0, len(input))
+	for _, r := range input {
+		buf = appendDecomposed(buf, r, isCompat)
+	}
+	reorderCombiningMarks(buf)
+	if form != NormalizationNFD {
+		buf = composeRunes(buf)
+	}
+	return string(buf)
+}
+
+func isAsciiString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func appendDecomposed(buf []rune, r rune, isCompat bool) []rune {
+	if r < minDecomposition {
+		return append(buf, r)
+	}
+	if hangulSBase <= r && r < hangulSBase+hangulSCount {
+		s := r - hangulSBase
+		buf = append(buf, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			buf = append(buf, hangulTBase+t)
+		}
+		return buf
+	}
+
+	if d := findDecomposition(r); d != nil {
+		if isCompat {
+			return append(buf, []rune(d.nfkd)...)
+		}
+		if len(d.nfd) > 0 {
+			return append(buf, []rune(d.nfd)...)
+		}
+	}
+	return append(buf, r)
+}
+
+func findDecomposition(r rune) *decomposition {
+	i := sort.Search(len(decompositions), func(i int) bool {
+		return decompositions[i].ch >= r
+	})
+	if i < len(decompositions) && decompositions[i].ch == r {
+		return &decompositions[i]
+	}
+	return nil
+}
+
+func combiningClassOf(r rune) uint8 {
+	if r < minCombiningMark {
+		return 0
+	}
+	i := sort.Search(len(combiningClasses), func(i int) bool {
+		return combiningClasses[i].hi >= r
+	})
+	if i < len(combiningClasses) && combiningClasses[i].lo <= r {
+		return combiningClasses[i].ccc
+	}
+	return 0
+}
+
+// reorderCombiningMarks sorts each sequence of combining marks by their canonical combining
+// classes, keeping the order of the marks with the same class.
+func reorderCombiningMarks(buf []rune) {
+	for i := 1; i < len(buf); i++ {
+		ccc := combiningClassOf(buf[i])
+		if ccc == 0 {
+			continue
+		}
+		for j := i; j > 0 && combiningClassOf(buf[j-1]) > ccc; j-- {
+			buf[j-1], buf[j] = buf[j], buf[j-1]
+		}
+	}
+}
+
+// composeRunes applies the canonical composition algorithm to the decomposed and reordered runes,
+// and returns the composed runes sharing the same array. The runes must not be empty.
+func composeRunes(buf []rune) []rune {
+	starterPos := 0
+	lastCcc := int(combiningClassOf(buf[0]))
+	if lastCcc != 0 {
+		lastCcc = 256 // blocks all the compositions with the non-starter at the head.
+	}
+	n := 1
+	for _, r := range buf[1:] {
+		ccc := int(combiningClassOf(r))
+		if c, ok := composePair(buf[starterPos], r); ok && (lastCcc == 0 || lastCcc < ccc) {
+			buf[starterPos] = c
+			continue
+		}
+		if ccc == 0 {
+			starterPos = n
+		}
+		lastCcc = ccc
+		buf[n] = r
+		n++
+	}
+	return buf[:n]
+}
+
+// compositionSeconds is the sorted characters which are composed with a preceding character by
+// the compositions table.
+var compositionSeconds = func() []rune {
+	seconds := make([]rune, 0, len(compositions))
+	for _, c := range compositions {
+		seconds = append(seconds, c.second)
+	}
+	sort.Slice(seconds, func(i, j int) bool { return seconds[i] < seconds[j] })
+	return seconds
+}()
+
+// isNormalizationBoundary reports whether the normalization form never reorders nor composes the
+// character with the characters before it, so the strings before and after it can be normalized
+// separately. It is true when the first character of its decomposition is a starter which is not
+// composed with a preceding character.
+func isNormalizationBoundary(r rune, form Normalization) bool {
+	if r < minDecomposition {
+		return true
+	}
+	first := r
+	if d := findDecomposition(r); d != nil {
+		if form == NormalizationNFKC {
+			first, _ = utf8.DecodeRuneInString(d.nfkd)
+		} else if len(d.nfd) > 0 {
+			first, _ = utf8.DecodeRuneInString(d.nfd)
+		}
+	}
+	if combiningClassOf(first) != 0 {
+		return false
+	}
+	if hangulVBase <= first && first < hangulVBase+hangulVCount ||
+		hangulTBase < first && first < hangulTBase+hangulTCount {
+		return false
+	}
+	i := sort.Search(len(compositionSeconds), func(i int) bool {
+		return compositionSeconds[i] >= first
+	})
+	return i == len(compositionSeconds) || compositionSeconds[i] != first
+}
+
+func composePair(first, second rune) (rune, bool) {
+	if hangulLBase <= first && first < hangulLBase+hangulLCount &&
+		hangulVBase <= second && second < hangulVBase+hangulVCount {
+		l, v := first-hangulLBase, second-hangulVBase
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if hangulSBase <= first && first < hangulSBase+hangulSCount &&
+		(first-hangulSBase)%hangulTCount == 0 &&
+		hangulTBase < second && second < hangulTBase+hangulTCount {
+		return first + (second - hangulTBase), true
+	}
+
+	i := sort.Search(len(compositions), func(i int) bool {
+		c := &compositions[i]
+		return c.first > first || (c.first == first && c.second >= second)
+	})
+	if i < len(compositions) && compositions[i].first == first && compositions[i].second == second {
+		return compositions[i].composed, true
+	}
+	return 0, false
+}
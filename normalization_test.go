@@ -0,0 +1,132 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestNormalization(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Unicode:                    true,
+	}
+
+	t.Run("NFC", func(t *testing.T) {
+		opts := origOpts
+		opts.Normalization = stringcase.NormalizationNFC
+
+		result := stringcase.SnakeCaseWithOptions("cafe\u0301Bar", opts)
+		assert.Equal(t, result, "caf\u00e9_bar")
+
+		result = stringcase.SnakeCaseWithOptions("caf\u00e9Bar", opts)
+		assert.Equal(t, result, "caf\u00e9_bar")
+
+		result = stringcase.PascalCaseWithOptions("a\u0301\u0316_b\u0301\u0301", opts)
+		assert.Equal(t, result, "\u00c1\u0316B\u0301\u0301")
+
+		result = stringcase.KebabCaseWithOptions("\u212bngstro\u0308m", opts)
+		assert.Equal(t, result, "\u00e5ngstr\u00f6m")
+
+		result = stringcase.CamelCaseWithOptions("\u0301foo_bar", opts)
+		assert.Equal(t, result, "fooBar")
+	})
+
+	t.Run("NFD", func(t *testing.T) {
+		opts := origOpts
+		opts.Normalization = stringcase.NormalizationNFD
+
+		result := stringcase.SnakeCaseWithOptions("caf\u00e9Bar", opts)
+		assert.Equal(t, result, "cafe\u0301_bar")
+
+		result = stringcase.SnakeCaseWithOptions("cafe\u0301Bar", opts)
+		assert.Equal(t, result, "cafe\u0301_bar")
+
+		result = stringcase.MacroCaseWithOptions("\u1e0b\u0323", opts)
+		assert.Equal(t, result, "D\u0323\u0307")
+	})
+
+	t.Run("NFKC", func(t *testing.T) {
+		opts := origOpts
+		opts.Normalization = stringcase.NormalizationNFKC
+
+		result := stringcase.SnakeCaseWithOptions("ﬀoo①Bar", opts)
+		assert.Equal(t, result, "ffoo1_bar")
+
+		result = stringcase.TitleCaseWithOptions("ＦｏｏＢａｒ", opts)
+		assert.Equal(t, result, "Foo Bar")
+
+		result = stringcase.CobolCaseWithOptions("\u1e9b\u0323", opts)
+		assert.Equal(t, result, "\u1e68")
+	})
+
+	t.Run("composition exclusions", func(t *testing.T) {
+		opts := origOpts
+		opts.Normalization = stringcase.NormalizationNFC
+		opts.Keep = "\u0915"
+
+		result := stringcase.SnakeCaseWithOptions("\u0958Foo", opts)
+		assert.Equal(t, result, "\u0915\u093c_foo")
+	})
+
+	t.Run("canonical ordering of the marks added in recent versions", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = false
+		opts.Normalization = stringcase.NormalizationNFD
+		opts.Keep = "\u0316\u0897\u1ad0"
+
+		result := stringcase.FlatCaseWithOptions("a\u0897\u0316", opts)
+		assert.Equal(t, result, "a\u0316\u0897")
+
+		result = stringcase.FlatCaseWithOptions("a\u1ad0\u0316", opts)
+		assert.Equal(t, result, "a\u0316\u1ad0")
+	})
+
+	t.Run("Hangul", func(t *testing.T) {
+		opts := origOpts
+		opts.Keep = "\uac01\u1100"
+
+		opts.Normalization = stringcase.NormalizationNFC
+		result := stringcase.SnakeCaseWithOptions("\u1100\u1161\u11a8Foo", opts)
+		assert.Equal(t, result, "\uac01_foo")
+
+		opts.Normalization = stringcase.NormalizationNFD
+		result = stringcase.SnakeCaseWithOptions("\uac01Foo", opts)
+		assert.Equal(t, result, "\u1100\u1161\u11a8_foo")
+	})
+
+	t.Run("without the Unicode option", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = false
+		opts.Normalization = stringcase.NormalizationNFKC
+
+		result := stringcase.SnakeCaseWithOptions("ﬀoo①Bar", opts)
+		assert.Equal(t, result, "ffoo1_bar")
+
+		result = stringcase.CamelCaseWithOptions("Ｆｏｏ_Ｂａｒ", opts)
+		assert.Equal(t, result, "fooBar")
+
+		result = stringcase.PascalCaseWithOptions("Ｆｏｏ_Ｂａｒ", opts)
+		assert.Equal(t, result, "FooBar")
+
+		result = stringcase.TrainCaseWithOptions("Ｆｏｏ_Ｂａｒ", opts)
+		assert.Equal(t, result, "Foo-Bar")
+
+		result = stringcase.MacroCaseWithOptions("Ｆｏｏ_Ｂａｒ", opts)
+		assert.Equal(t, result, "FOO_BAR")
+	})
+
+	t.Run("no normalization", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("ﬀoo①Bar", opts)
+		assert.Equal(t, result, "ﬀoo_bar")
+
+		opts.Normalization = stringcase.NormalizationNFKC
+		result = stringcase.SnakeCaseWithOptions("fooBar", opts)
+		assert.Equal(t, result, "foo_bar")
+	})
+}
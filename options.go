@@ -4,6 +4,12 @@
 
 package stringcase
 
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
 // Options is a struct that represents options for case conversion of strings.
 //
 // The SeparateBeforeNonAlphabets field specifies whether to treat the
@@ -18,9 +24,254 @@ package stringcase
 // Alphanumeric characters specified in Separators and Keep are ignored.
 // If both Separators and Keep are specified, Separators takes precedence
 // and Keep is ignored.
+//
+// The Boundaries field specifies the kinds of word boundaries to detect
+// individually, such as between a lowercase and an uppercase letter,
+// between a letter and a digit, and around kept symbols. When it is not
+// zero, SeparateBeforeNonAlphabets and SeparateAfterNonAlphabets are
+// ignored. BoundaryNone disables all the kinds of boundaries, so only
+// separators split words.
+//
+// The Unicode field specifies whether to classify and case-map characters
+// by their Unicode categories instead of only ASCII letters and digits.
+// When it is true, uppercase and titlecase letters, lowercase letters,
+// and decimal digits of any script are treated like their ASCII
+// counterparts, so word boundaries and case conversions work for scripts
+// such as Latin-1, Latin Extended, Greek, and Cyrillic. The case mappings
+// include the ones to more than one character, such as ß to SS and ﬁ to
+// FI, and Greek capital sigma is lowercased to final sigma at the end of a
+// word. Letters without case and the other characters are still handled
+// by Separators and Keep.
+// Characters are also processed by extended grapheme clusters, so
+// combining marks, emoji sequences, and pairs of regional indicators are
+// never separated from their base characters. A grapheme cluster is
+// classified by its first character.
+// When it is false, which is the default, only ASCII letters and digits
+// are alphanumeric.
+//
+// The Locale field specifies the language whose casing rules are applied
+// in addition to the default Unicode case mappings, such as dotted and
+// dotless i in Turkish and Azeri, the dot above i and j in Lithuanian,
+// and the IJ digraph in Dutch. This field takes effect only when the
+// Unicode field is true.
+//
+// The Normalization field specifies the Unicode normalization form to
+// which input strings are normalized before they are split into words,
+// so strings which look identical, such as the ones in NFC and NFD, are
+// converted to the same result. NFKC also folds compatibility characters,
+// such as "①" to "1" and "ﬀ" to "ff". This field takes effect regardless
+// of the Unicode field.
+//
+// The FoldToASCII field specifies whether to replace Latin letters with
+// their ASCII equivalents before input strings are split into words, such
+// as "è" to "e", "Æ" to "AE", "Ł" to "L", and "ß" to "ss", so that the
+// results consist only of ASCII letters for Latin scripts. Combining marks
+// following the letters are removed, and characters of the other scripts
+// are left as they are. This field takes effect regardless of the Unicode
+// field, and is applied after the normalization.
+//
+// The SeparateScripts field specifies whether to treat the points where
+// the script of letters changes, such as between Latin, Han, Hiragana,
+// Katakana, and Hangul, as word boundaries. When it is true, letters
+// without case are also kept in words instead of being handled by
+// Separators and Keep. Letters of the Common and Inherited scripts, such
+// as the prolonged sound mark "ー", continue the script of the preceding
+// letter. Since the scripts are found by the Unicode properties of
+// letters, this field implies the Unicode field is true.
+//
+// The Acronyms field specifies the words which are written in all caps,
+// such as "ID" and "URL", in the cases which capitalize words, such as
+// PascalCase, camelCase, Ada_Case, Title Case, and Train-Case. A word
+// matches an acronym when they are equal ignoring case, so "user_id" is
+// converted to "UserID" in PascalCase. The first word in camelCase is
+// still lowercased. GoInitialisms returns a preset of the acronyms for
+// Go identifiers.
+//
+// The AcronymStyle field specifies how to render acronyms in those cases:
+// the words in Acronyms in all caps (the default), all words capitalized,
+// the words written in all caps in the input kept in all caps, or both of
+// the words in Acronyms and the ones in all caps in the input in all caps.
+//
+// The AcronymPlurals field specifies whether to treat a lowercase "s"
+// following a sequence of uppercase letters as the plural suffix of an
+// acronym when it is not followed by a lowercase letter, so "userIDs" is
+// converted to "user_ids" and "APIsList" to "apis_list" in snake_case,
+// instead of "user_i_ds" and "ap_is_list". The plural suffix is also
+// detected without this field when the uppercase letters match one of
+// Acronyms. In the cases which capitalize words, the plural of an acronym
+// rendered in all caps is written like "IDs".
+//
+// The ProtectedWords field specifies the words which are never split or
+// recased, such as product and brand names like "iOS", "OAuth2", and
+// "k8s". They are matched ignoring case at the positions where words can
+// start, unless they are followed by a lowercase letter, or by a digit if
+// they end with a digit, or the matched text is in all caps and continues
+// with uppercase letters, like "IOSTREAM". A matched word is treated as a
+// single word, and is written in its spelling in this field in the cases
+// which capitalize words, so "ios_app" is converted to "iOSApp" in
+// PascalCase. The first word of camelCase keeps the spelling only if it
+// starts with a lowercase letter, like "macOS", since camelCase starts
+// with a lowercase letter. In the cases which lowercase or uppercase
+// words, it is cased as usual.
+//
+// The TitleStyle field specifies the style guide of title case, such as AP
+// and Chicago, which lowercases minor words like articles, short
+// conjunctions, and prepositions except at the start and end of the title.
+// The MinorWords field specifies additional minor words, which are matched
+// ignoring case and are lowercased also when TitleStyle is TitleStyleNone.
+// These fields are used only by TitleCaseWithOptions and
+// TitleCaseWithMapping.
+//
+// Acronyms, ProtectedWords, and MinorWords are WordList values created by
+// NewWordList, so Options stays comparable with == and usable as map keys.
 type Options struct {
 	SeparateBeforeNonAlphabets bool
 	SeparateAfterNonAlphabets  bool
 	Separators                 string
 	Keep                       string
+	Boundaries                 Boundary
+	Unicode                    bool
+	Locale                     Locale
+	Normalization              Normalization
+	FoldToASCII                bool
+	SeparateScripts            bool
+	Acronyms                   WordList
+	AcronymStyle               AcronymStyle
+	AcronymPlurals             bool
+	ProtectedWords             WordList
+	TitleStyle                 TitleStyle
+	MinorWords                 WordList
+
+	// marks and words are the precompiled lookups of Separators or Keep, and of Acronyms and
+	// ProtectedWords, which are set by NewConverter.
+	marks *markSet
+	words *wordSet
+}
+
+// WordList is an immutable list of words, such as acronyms and protected words, which is specified
+// in the fields of Options. It is a comparable handle of the words, and two WordList values are
+// equal when they are copies of the value returned by the same call of NewWordList, or both of them
+// are the zero value, which is an empty list.
+type WordList struct {
+	list *[]string
+}
+
+// NewWordList returns a WordList of a copy of the words. It returns the zero value of WordList if
+// no words are given.
+func NewWordList(words ...string) WordList {
+	if len(words) == 0 {
+		return WordList{}
+	}
+	list := append([]string(nil), words...)
+	return WordList{list: &list}
+}
+
+// Words returns a copy of the words in the list.
+func (l WordList) Words() []string {
+	return append([]string(nil), l.words()...)
+}
+
+func (l WordList) words() []string {
+	if l.list == nil {
+		return nil
+	}
+	return *l.list
+}
+
+// isUnicode reports whether characters are classified and case-mapped by their Unicode categories,
+// which is specified by the Unicode field, or implied by the SeparateScripts field.
+func (opts *Options) isUnicode() bool {
+	return opts.Unicode || opts.SeparateScripts
+}
+
+// preprocess applies the normalization and the ASCII folding specified in the options to the
+// input string before it is split into words.
+func (opts *Options) preprocess(input string) string {
+	input = normalize(input, opts.Normalization)
+	if opts.FoldToASCII {
+		input = foldToAscii(input)
+	}
+	return input
+}
+
+// offsetMap maps byte offsets in a preprocessed string back to those in the original input string.
+// The input string is divided into segments at the characters which isPreprocessBoundary accepts,
+// and out and in hold the start offsets of the segments in both strings, with the lengths of both
+// strings at the end. Since the preprocessing never changes characters across those boundaries,
+// each segment is preprocessed independently. A nil offsetMap maps each offset to itself.
+type offsetMap struct {
+	out []int
+	in  []int
+}
+
+// preprocessWithOffsets works like preprocess, but also returns the offsetMap from the result to
+// the input string.
+func (opts *Options) preprocessWithOffsets(input string) (string, offsetMap) {
+	if opts.Normalization == NormalizationNone && !opts.FoldToASCII {
+		return input, offsetMap{}
+	}
+
+	m := offsetMap{out: make([]int, 0, len(input)+1), in: make([]int, 0, len(input)+1)}
+	pos := 0
+	start := 0
+	var base rune
+	for i, r := range input {
+		if i > start && opts.isPreprocessBoundary(base, r) {
+			m.out = append(m.out, pos)
+			m.in = append(m.in, start)
+			pos += len(opts.preprocess(input[start:i]))
+			start = i
+		}
+		if !unicode.Is(unicode.Mn, r) {
+			base = r
+		}
+	}
+	m.out = append(m.out, pos, pos+len(opts.preprocess(input[start:])))
+	m.in = append(m.in, start, len(input))
+
+	return opts.preprocess(input), m
+}
+
+// isPreprocessBoundary reports whether the preprocessing never changes the characters across the
+// point before the rune r. base is the last character before r other than combining marks. The
+// ASCII folding removes the combining marks following a letter, and looks ahead for a lowercase
+// letter to titlecase a letter folded to more than one letter, so the point is not a boundary
+// before them.
+func (opts *Options) isPreprocessBoundary(base, r rune) bool {
+	if opts.Normalization != NormalizationNone && !isNormalizationBoundary(r, opts.Normalization) {
+		return false
+	}
+	if opts.FoldToASCII {
+		if opts.Normalization != NormalizationNone {
+			base, _ = utf8.DecodeRuneInString(normalize(string(base), opts.Normalization))
+			r, _ = utf8.DecodeRuneInString(normalize(string(r), opts.Normalization))
+		}
+		return !unicode.Is(unicode.Mn, r) && (len(asciiFoldOf(base)) < 2 || !unicode.IsLower(r))
+	}
+	return true
+}
+
+// start maps the start offset of a range in the preprocessed string. An offset inside the result
+// of a segment is moved to the start of the segment.
+func (m offsetMap) start(pos int) int {
+	if m.out == nil {
+		return pos
+	}
+	i := sort.Search(len(m.out), func(i int) bool { return m.out[i] > pos })
+	return m.in[i-1]
+}
+
+// end maps the end offset of a range in the preprocessed string. An offset inside the result of a
+// segment is moved to the end of the segment.
+func (m offsetMap) end(pos int) int {
+	if m.out == nil {
+		return pos
+	}
+	i := sort.Search(len(m.out), func(i int) bool { return m.out[i] >= pos })
+	return m.in[i]
+}
+
+// hasAcronymRules reports whether some words to be capitalized may be rendered in all caps.
+func (opts *Options) hasAcronymRules() bool {
+	return len(opts.Acronyms.words()) > 0 || opts.AcronymStyle != AcronymStyleDefault
 }
@@ -0,0 +1,45 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestWordList(t *testing.T) {
+	t.Run("copy the words", func(t *testing.T) {
+		words := []string{"ID", "URL"}
+		list := stringcase.NewWordList(words...)
+		words[0] = "XML"
+		assert.Equal(t, list.Words(), []string{"ID", "URL"})
+
+		list.Words()[1] = "API"
+		assert.Equal(t, list.Words(), []string{"ID", "URL"})
+
+		opts := stringcase.Options{Acronyms: list}
+		assert.Equal(t, stringcase.PascalCaseWithOptions("xml_url", opts), "XmlURL")
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		assert.Equal(t, stringcase.NewWordList(), stringcase.WordList{})
+		assert.Nil(t, stringcase.WordList{}.Words())
+	})
+
+	t.Run("compare options", func(t *testing.T) {
+		acronyms := stringcase.NewWordList("ID")
+		opts1 := stringcase.Options{SeparateAfterNonAlphabets: true, Acronyms: acronyms}
+		opts2 := stringcase.Options{SeparateAfterNonAlphabets: true, Acronyms: acronyms}
+		assert.True(t, opts1 == opts2)
+
+		opts2.Acronyms = stringcase.NewWordList("ID")
+		assert.False(t, opts1 == opts2)
+
+		converters := map[stringcase.Options]string{opts1: "pascal"}
+		assert.Equal(t, converters[stringcase.Options{
+			SeparateAfterNonAlphabets: true,
+			Acronyms:                  acronyms,
+		}], "pascal")
+	})
+}
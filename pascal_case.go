@@ -4,79 +4,17 @@
 
 package stringcase
 
-import (
-	"strings"
-)
-
 // PascalCaseWithOptions converts the input string to pascal case with the
 // specified options.
 func PascalCaseWithOptions(input string, opts Options) string {
-	result := make([]rune, 0, len(input))
-
-	const (
-		ChIsFirstOfStr = iota
-		ChIsNextOfUpper
-		ChIsNextOfContdUpper
-		ChIsNextOfSepMark
-		ChIsNextOfKeptMark
-		ChIsOther
-	)
-	var flag uint8 = ChIsFirstOfStr
-
-	for _, ch := range input {
-		if isAsciiUpperCase(ch) {
-			if flag == ChIsNextOfUpper || flag == ChIsNextOfContdUpper ||
-				(!opts.SeparateAfterNonAlphabets && flag == ChIsNextOfKeptMark) {
-				result = append(result, toAsciiLowerCase(ch))
-				flag = ChIsNextOfContdUpper
-			} else {
-				result = append(result, ch)
-				flag = ChIsNextOfUpper
-			}
-		} else if isAsciiLowerCase(ch) {
-			if flag == ChIsFirstOfStr {
-				result = append(result, toAsciiUpperCase(ch))
-			} else if flag == ChIsNextOfContdUpper {
-				n := len(result)
-				prev := result[n-1]
-				if isAsciiLowerCase(prev) {
-					prev = toAsciiUpperCase(prev)
-				}
-				result[n-1] = prev
-				result = append(result, ch)
-			} else if flag == ChIsNextOfSepMark ||
-				(opts.SeparateAfterNonAlphabets && flag == ChIsNextOfKeptMark) {
-				result = append(result, toAsciiUpperCase(ch))
-			} else {
-				result = append(result, ch)
-			}
-			flag = ChIsOther
-		} else {
-			isKeptChar := false
-			if isAsciiDigit(ch) {
-				isKeptChar = true
-			} else if len(opts.Separators) > 0 {
-				if !strings.ContainsRune(opts.Separators, ch) {
-					isKeptChar = true
-				}
-			} else if len(opts.Keep) > 0 {
-				if strings.ContainsRune(opts.Keep, ch) {
-					isKeptChar = true
-				}
-			}
-
-			if isKeptChar {
-				result = append(result, ch)
-				flag = ChIsNextOfKeptMark
-			} else {
-				if flag != ChIsFirstOfStr {
-					flag = ChIsNextOfSepMark
-				}
-			}
-		}
-	}
+	return Format(input, stylePascal, opts)
+}
 
-	return string(result)
+// PascalCaseWithMapping converts the input string to pascal case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func PascalCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, stylePascal, opts)
 }
 
 // PascalCase converts the input string to pascal case.
@@ -84,8 +22,30 @@ func PascalCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func PascalCase(input string) string {
-	return PascalCaseWithOptions(input, Options{
+	return Format(input, stylePascal, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendPascalCase appends the input string converted to pascal case to dst
+// and returns the extended buffer, in the same way as PascalCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendPascalCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, stylePascal, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// IsPascalCaseWithOptions reports whether the input string is in pascal case
+// with the specified options, that is, whether PascalCaseWithOptions
+// returns it unchanged.
+func IsPascalCaseWithOptions(input string, opts Options) bool {
+	return PascalCaseWithOptions(input, opts) == input
+}
+
+// IsPascalCase reports whether the input string is in pascal case.
+func IsPascalCase(input string) bool {
+	return PascalCase(input) == input
+}
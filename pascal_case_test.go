@@ -1286,3 +1286,82 @@ func TestPascalCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestPascalCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.PascalCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "ABC1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 1, InStart: 2, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 3},
+			{OutStart: 2, OutEnd: 2, InStart: 3, InEnd: 4},
+			{OutStart: 2, OutEnd: 3, InStart: 4, InEnd: 5},
+			{OutStart: 3, OutEnd: 4, InStart: 5, InEnd: 6},
+			{OutStart: 4, OutEnd: 4, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.PascalCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsPascalCase(t *testing.T) {
+	t.Run("string in pascal case", func(t *testing.T) {
+		assert.True(t, stringcase.IsPascalCase("UserAccountSettings"))
+		assert.True(t, stringcase.IsPascalCase(""))
+	})
+
+	t.Run("string not in pascal case", func(t *testing.T) {
+		assert.False(t, stringcase.IsPascalCase("user_account_settings"))
+		assert.False(t, stringcase.IsPascalCase("UserAccountSettings_"))
+		assert.False(t, stringcase.IsPascalCase("Rate%Limit"))
+	})
+}
+
+func TestIsPascalCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in pascal case", func(t *testing.T) {
+		assert.True(t, stringcase.IsPascalCaseWithOptions("Rate%Limit", opts))
+		assert.True(t, stringcase.IsPascalCaseWithOptions("UserAccountSettings", opts))
+	})
+
+	t.Run("string not in pascal case", func(t *testing.T) {
+		assert.False(t, stringcase.IsPascalCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsPascalCaseWithOptions("UserAccountSettings_", opts))
+	})
+}
+
+func TestAppendPascalCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendPascalCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=UserAccountSettings")
+
+		buf = stringcase.AppendPascalCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "UserAccountSettings")
+	})
+
+	t.Run("same result as PascalCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendPascalCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.PascalCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendPascalCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// PathCaseWithOptions converts the input string to path case with the
+// specified options.
+func PathCaseWithOptions(input string, opts Options) string {
+	return Format(input, stylePath, opts)
+}
+
+// PathCaseWithMapping converts the input string to path case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func PathCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, stylePath, opts)
+}
+
+// PathCase converts the input string to path case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func PathCase(input string) string {
+	return Format(input, stylePath, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendPathCase appends the input string converted to path case to dst
+// and returns the extended buffer, in the same way as PathCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendPathCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, stylePath, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsPathCaseWithOptions reports whether the input string is in path case
+// with the specified options, that is, whether PathCaseWithOptions
+// returns it unchanged.
+func IsPathCaseWithOptions(input string, opts Options) bool {
+	return PathCaseWithOptions(input, opts) == input
+}
+
+// IsPathCase reports whether the input string is in path case.
+func IsPathCase(input string) bool {
+	return PathCase(input) == input
+}
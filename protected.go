@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// matchProtectedWord returns the longest word in opts.ProtectedWords which matches the input string
+// from the byte offset i ignoring case, or an empty string if there is not such a word. A match is
+// rejected when it is followed by a lowercase letter, or by a digit if the word ends with a digit,
+// or when it ends in the middle of a grapheme cluster if opts.Unicode is true. An all-caps match
+// is also rejected when it is followed by an uppercase letter which continues the all-caps word,
+// that is, which is not followed by a lowercase letter, so "IOSTREAM" does not match "iOS" but
+// "IOSApp" does.
+func matchProtectedWord(input string, i int, opts *Options) string {
+	rest := input[i:]
+	words := opts.ProtectedWords.words()
+	if opts.words != nil {
+		ch, _ := utf8.DecodeRuneInString(rest)
+		words = opts.words.protectedWords(ch)
+	}
+	matched := ""
+	for _, w := range words {
+		if len(w) <= len(matched) || len(w) > len(rest) || !strings.EqualFold(rest[:len(w)], w) {
+			continue
+		}
+		if len(w) < len(rest) {
+			next, _ := utf8.DecodeRuneInString(rest[len(w):])
+			if isLowerCase(next, opts.isUnicode()) {
+				continue
+			}
+			if isUpperCase(next, opts.isUnicode()) && !hasLowerCase(rest[:len(w)]) {
+				after, _ := utf8.DecodeRuneInString(rest[len(w)+utf8.RuneLen(next):])
+				if !isLowerCase(after, opts.isUnicode()) {
+					continue
+				}
+			}
+			last, _ := utf8.DecodeLastRuneInString(w)
+			if isDigit(next, opts.isUnicode()) && isDigit(last, opts.isUnicode()) {
+				continue
+			}
+			if opts.isUnicode() && !isGraphemeClusterEnd(rest, len(w)) {
+				continue
+			}
+		}
+		matched = w
+	}
+	return matched
+}
+
+// isGraphemeClusterEnd reports whether the byte offset n of s is at the end of a grapheme cluster.
+func isGraphemeClusterEnd(s string, n int) bool {
+	i := 0
+	for i < n {
+		i += graphemeClusterLen(s[i:])
+	}
+	return i == n
+}
+
+// appendProtectedWord appends a protected word in the input string to result, converting its
+// letters with the case mapping m, which is mapToLower or mapToUpper, and keeping the other
+// characters including separators. A capital sigma at the end of the word or before a non-letter
+// is lowercased to final sigma if it follows a letter.
+func appendProtectedWord(result []byte, word string, m caseMapping, opts *Options) []byte {
+	locale := opts.locale()
+	isAfterLetter := false
+
+	for i := 0; i < len(word); {
+		ch, size := utf8.DecodeRuneInString(word[i:])
+		end := i + size
+		if opts.isUnicode() {
+			end = i + graphemeClusterLen(word[i:])
+		}
+		marks := word[i+size : end]
+
+		if isUpperCase(ch, opts.isUnicode()) || isLowerCase(ch, opts.isUnicode()) {
+			next, _ := utf8.DecodeRuneInString(word[end:])
+			if ch == capitalSigma && m == mapToLower && isAfterLetter &&
+				!isUpperCase(next, opts.isUnicode()) && !isLowerCase(next, opts.isUnicode()) {
+				result = utf8.AppendRune(result, finalSigma)
+				result = appendCasedMarks(result, ch, marks, m, locale)
+			} else {
+				result = appendCasedLetter(result, ch, marks, m, locale)
+			}
+			isAfterLetter = true
+		} else {
+			result = append(result, word[i:end]...)
+			isAfterLetter = false
+		}
+
+		i = end
+	}
+
+	return result
+}
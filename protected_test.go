@@ -0,0 +1,212 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestProtectedWords(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		ProtectedWords:             stringcase.NewWordList("iOS", "macOS", "OAuth2", "GraphQL", "IPv6", "k8s"),
+	}
+
+	t.Run("never split protected words", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("OAuth2Token", opts)
+		assert.Equal(t, result, "oauth2_token")
+
+		result = stringcase.KebabCaseWithOptions("useGraphQLClient", opts)
+		assert.Equal(t, result, "use-graphql-client")
+
+		result = stringcase.MacroCaseWithOptions("macOSVersion", opts)
+		assert.Equal(t, result, "MACOS_VERSION")
+
+		result = stringcase.CobolCaseWithOptions("k8sCluster", opts)
+		assert.Equal(t, result, "K8S-CLUSTER")
+
+		result = stringcase.SnakeCaseWithOptions("IPv6Address_IPv4", opts)
+		assert.Equal(t, result, "ipv6_address_i_pv4")
+	})
+
+	t.Run("do not match inside all-caps words", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("IOSTREAM", opts)
+		assert.Equal(t, result, "iostream")
+
+		result = stringcase.PascalCaseWithOptions("IOSTREAM_FILE", opts)
+		assert.Equal(t, result, "IostreamFile")
+
+		result = stringcase.SnakeCaseWithOptions("MACOSX", opts)
+		assert.Equal(t, result, "macosx")
+
+		result = stringcase.SnakeCaseWithOptions("IOSApp", opts)
+		assert.Equal(t, result, "ios_app")
+	})
+
+	t.Run("keep canonical spellings in capitalized cases", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.PascalCaseWithOptions("ios_app", opts)
+		assert.Equal(t, result, "iOSApp")
+
+		result = stringcase.PascalCaseWithOptions("IOSApp", opts)
+		assert.Equal(t, result, "iOSApp")
+
+		result = stringcase.CamelCaseWithOptions("app_for_ios", opts)
+		assert.Equal(t, result, "appForiOS")
+
+		result = stringcase.CamelCaseWithOptions("ios_app", opts)
+		assert.Equal(t, result, "iOSApp")
+
+		result = stringcase.CamelCaseWithOptions("macos_version", opts)
+		assert.Equal(t, result, "macOSVersion")
+
+		result = stringcase.CamelCaseWithOptions("graphql_api", opts)
+		assert.Equal(t, result, "graphqlApi")
+
+		result = stringcase.TitleCaseWithOptions("graphql over oauth2", opts)
+		assert.Equal(t, result, "GraphQL Over OAuth2")
+
+		result = stringcase.AdaCaseWithOptions("MACOS-IPV6", opts)
+		assert.Equal(t, result, "macOS_IPv6")
+
+		result = stringcase.TrainCaseWithOptions("run_k8s", opts)
+		assert.Equal(t, result, "Run-k8s")
+
+		result = stringcase.Capitalize("ios.k8s", '.', opts)
+		assert.Equal(t, result, "iOS.k8s")
+	})
+
+	t.Run("reject matches followed by lowercase letters or digits", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("iosevka", opts)
+		assert.Equal(t, result, "iosevka")
+
+		result = stringcase.PascalCaseWithOptions("ipv66_oauth22", opts)
+		assert.Equal(t, result, "Ipv66Oauth22")
+
+		result = stringcase.PascalCaseWithOptions("ios2", opts)
+		assert.Equal(t, result, "iOS2")
+
+		result = stringcase.PascalCaseWithOptions("myios", opts)
+		assert.Equal(t, result, "Myios")
+	})
+
+	t.Run("match words starting before lowercase letters", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("HTTPGraphQLClient", opts)
+		assert.Equal(t, result, "http_graphql_client")
+
+		result = stringcase.MacroCaseWithOptions("HTTPGraphQLClient", opts)
+		assert.Equal(t, result, "HTTP_GRAPHQL_CLIENT")
+
+		result = stringcase.PascalCaseWithOptions("HTTPGraphQLClient", opts)
+		assert.Equal(t, result, "HttpGraphQLClient")
+
+		result = stringcase.CamelCaseWithOptions("HTTPGraphQLClient", opts)
+		assert.Equal(t, result, "httpGraphQLClient")
+
+		result = stringcase.TrainCaseWithOptions("HTTPGraphQLClient", opts)
+		assert.Equal(t, result, "Http-GraphQL-Client")
+	})
+
+	t.Run("match words at script changes", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.SeparateScripts = true
+
+		result := stringcase.SnakeCaseWithOptions("\u0391\u0392ios\u30ad\u30fc", opts)
+		assert.Equal(t, result, "\u03b1_\u03b2_ios_\u30ad\u30fc")
+
+		result = stringcase.MacroCaseWithOptions("\u0391\u0392ios", opts)
+		assert.Equal(t, result, "\u0391_\u0392_IOS")
+
+		result = stringcase.PascalCaseWithOptions("\u0391\u0392ios", opts)
+		assert.Equal(t, result, "\u0391\u0392iOS")
+
+		result = stringcase.CamelCaseWithOptions("\u0391\u0392ios", opts)
+		assert.Equal(t, result, "\u03b1\u0392iOS")
+
+		result = stringcase.CamelCaseWithOptions("x_\u0391\u0392ios", opts)
+		assert.Equal(t, result, "x\u0391\u0392iOS")
+
+		result = stringcase.TrainCaseWithOptions("\u0391\u0392ios", opts)
+		assert.Equal(t, result, "\u0391-\u0392-iOS")
+	})
+
+	t.Run("case letters of protected words in lowercase or uppercase", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.ProtectedWords = stringcase.NewWordList("\u039f\u0394\u039f\u03a3", "C_D")
+
+		result := stringcase.SnakeCaseWithOptions("\u039f\u0394\u039f\u03a3Xy", opts)
+		assert.Equal(t, result, "\u03bf\u03b4\u03bf\u03c2_xy")
+
+		result = stringcase.KebabCaseWithOptions("c_d_e", opts)
+		assert.Equal(t, result, "c_d-e")
+
+		result = stringcase.MacroCaseWithOptions("c_d_e", opts)
+		assert.Equal(t, result, "C_D_E")
+
+		result = stringcase.PascalCaseWithOptions("c_d_e", opts)
+		assert.Equal(t, result, "C_DE")
+	})
+
+	t.Run("match the longest word", func(t *testing.T) {
+		opts := origOpts
+		opts.ProtectedWords = stringcase.NewWordList("Graph", "GraphQL", "QLearn")
+
+		result := stringcase.PascalCaseWithOptions("graphql_graph_ql", opts)
+		assert.Equal(t, result, "GraphQLGraphQl")
+	})
+
+	t.Run("with other options", func(t *testing.T) {
+		opts := origOpts
+		opts.Acronyms = stringcase.NewWordList(stringcase.GoInitialisms()...)
+
+		result := stringcase.PascalCaseWithOptions("ios_api_id", opts)
+		assert.Equal(t, result, "iOSAPIID")
+
+		opts.Boundaries = stringcase.BoundaryLowerUpper | stringcase.BoundaryLetterDigit
+		result = stringcase.SnakeCaseWithOptions("OAuth2Token_utf8", opts)
+		assert.Equal(t, result, "oauth2_token_utf_8")
+
+		opts = origOpts
+		opts.Unicode = true
+		opts.ProtectedWords = stringcase.NewWordList("Ωmega", "e")
+		result = stringcase.PascalCaseWithOptions("ωMEGA_ΩMEGAS_e\u0301", opts)
+		assert.Equal(t, result, "ΩmegaΩmegasE\u0301")
+
+		opts.Keep = "#"
+		opts.ProtectedWords = stringcase.NewWordList("C#")
+		result = stringcase.PascalCaseWithOptions("c#_code", opts)
+		assert.Equal(t, result, "C#Code")
+
+		result = stringcase.PascalCaseWithOptions("go#_c#", opts)
+		assert.Equal(t, result, "Go#C#")
+
+		opts.Separators = "_"
+		result = stringcase.SnakeCaseWithOptions("c#%ios", opts)
+		assert.Equal(t, result, "c#_%_ios")
+	})
+
+	t.Run("without protected words", func(t *testing.T) {
+		opts := origOpts
+		opts.ProtectedWords = stringcase.WordList{}
+
+		result := stringcase.SnakeCaseWithOptions("OAuth2Token", opts)
+		assert.Equal(t, result, "o_auth2_token")
+
+		result = stringcase.PascalCaseWithOptions("ios_app", opts)
+		assert.Equal(t, result, "IosApp")
+	})
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	chIsFirstOfStr uint8 = iota
+	chIsNextOfUpper
+	chIsNextOfContdUpper
+	chIsNextOfSepMark
+	chIsNextOfKeptMark
+	chIsOther
+)
+
+// wordScanner walks through an input string unit by unit and decides whether each unit starts a
+// new word with the flag states. It is the only place where word boundaries are found, and all the
+// conversion functions, Words and Scanner are built on it. Instead of rewriting the result
+// afterwards when a lowercase letter follows a sequence of uppercase letters, it looks one unit
+// ahead, so every unit is reported only once and with its final word boundary.
+//
+// A unit is a rune, or an extended grapheme cluster when Options.Unicode is true. A grapheme
+// cluster is classified by its first rune, and the following runes, such as combining marks and
+// the rest of an emoji sequence, always belong to the same unit. A word in Options.ProtectedWords
+// is reported as a single unit.
+type wordScanner struct {
+	input          string
+	opts           *Options
+	locale         Locale
+	pos            int
+	flag           uint8
+	lastClass      uint8
+	runStart       int
+	isDutchIJ      bool
+	isAcronymSplit bool
+	script         *unicode.RangeTable
+
+	start     int
+	markPos   int
+	end       int
+	ch        rune
+	class     uint8
+	prevClass uint8
+	isHead    bool
+	newWord   bool
+	protected string
+}
+
+func newWordScanner(input string, opts *Options) wordScanner {
+	return wordScanner{input: input, opts: opts, locale: opts.locale(), flag: chIsFirstOfStr}
+}
+
+// scan advances the scanner to the next unit and reports whether there is one. After it returns
+// true, start and end hold the byte range of the unit, ch its first rune, markPos the byte offset
+// of the runes following ch in the unit, class its class, and prevClass the class of the preceding
+// unit other than separators. isHead reports whether the unit is the first one other than
+// separators in the input string, and newWord whether a word boundary lies just before the unit.
+// If a word in Options.ProtectedWords starts at the unit, protected holds its spelling in
+// Options.ProtectedWords, and the unit covers the whole word.
+func (s *wordScanner) scan() bool {
+	if s.pos >= len(s.input) {
+		return false
+	}
+
+	i := s.pos
+	ch, size := rune(s.input[i]), 1
+	if ch >= utf8.RuneSelf {
+		ch, size = utf8.DecodeRuneInString(s.input[i:])
+	}
+	end := i + size
+	if s.opts.isUnicode() {
+		end = i + graphemeClusterLen(s.input[i:])
+	}
+	class := charClassOf(ch, s.opts)
+	isNewScript := false
+	if s.opts.SeparateScripts && unicode.IsLetter(ch) {
+		if script := scriptOf(ch); script != nil {
+			isNewScript = s.script != nil && script != s.script
+			s.script = script
+		}
+	}
+
+	s.start, s.markPos, s.end, s.pos = i, i+size, end, end
+	s.ch = ch
+	s.class = class
+	s.prevClass = s.lastClass
+	s.isHead = s.flag == chIsFirstOfStr && class != charIsSepMark
+	s.newWord = false
+	s.protected = ""
+	isAfterAcronym := s.isAcronymSplit
+	s.isAcronymSplit = false
+
+	switch class {
+	case charIsUpper:
+		if s.flag != chIsNextOfUpper && s.flag != chIsNextOfContdUpper {
+			s.runStart = i
+		}
+		if s.isDutchIJ && isDutchJ(ch) {
+			s.flag = chIsNextOfUpper
+			s.isDutchIJ = false
+		} else if s.flag != chIsFirstOfStr && s.flag != chIsNextOfSepMark &&
+			!s.opts.isWordBoundary(s.lastClass, charIsUpper) && !isNewScript {
+			if s.lastClass == charIsUpper || s.opts.Boundaries == 0 {
+				s.flag = chIsNextOfContdUpper
+				s.isAcronymSplit = s.isLowerFollowing()
+				s.newWord = s.isAcronymSplit
+			} else {
+				s.flag = chIsNextOfUpper
+			}
+			s.isDutchIJ = false
+		} else {
+			s.newWord = s.flag != chIsFirstOfStr
+			s.flag = chIsNextOfUpper
+			s.isDutchIJ = s.locale == LocaleDutch && isDutchI(ch)
+		}
+		s.lastClass = charIsUpper
+	case charIsLower:
+		if s.flag == chIsFirstOfStr {
+			s.isDutchIJ = s.locale == LocaleDutch && isDutchI(ch)
+		} else if isAfterAcronym {
+			s.newWord = isNewScript
+			s.isDutchIJ = isNewScript && s.locale == LocaleDutch && isDutchI(ch)
+		} else if s.flag == chIsNextOfSepMark ||
+			s.opts.isWordBoundary(s.lastClass, charIsLower) || isNewScript {
+			s.newWord = true
+			s.isDutchIJ = s.locale == LocaleDutch && isDutchI(ch)
+		} else {
+			s.isDutchIJ = false
+		}
+		s.flag = chIsOther
+		s.lastClass = charIsLower
+	case charIsCaseless:
+		s.newWord = s.flag != chIsFirstOfStr && (s.flag == chIsNextOfSepMark ||
+			s.opts.isWordBoundary(s.lastClass, charIsCaseless) || isNewScript)
+		s.flag = chIsOther
+		s.lastClass = charIsCaseless
+		s.isDutchIJ = false
+	case charIsDigit, charIsKeptMark:
+		s.newWord = s.flag == chIsNextOfSepMark ||
+			(s.flag != chIsFirstOfStr && s.opts.isWordBoundary(s.lastClass, class))
+		s.flag = chIsNextOfKeptMark
+		s.lastClass = class
+		s.isDutchIJ = false
+	default:
+		if s.flag != chIsFirstOfStr {
+			s.flag = chIsNextOfSepMark
+		}
+		s.isDutchIJ = false
+	}
+
+	if (s.isHead || s.newWord) && len(s.opts.ProtectedWords.words()) > 0 {
+		if word := matchProtectedWord(s.input, i, s.opts); len(word) > 0 {
+			s.protected = word
+			s.end = i + len(word)
+			s.pos = s.end
+			s.flag = chIsNextOfSepMark
+			s.isDutchIJ = false
+			s.isAcronymSplit = false
+		}
+	}
+
+	return true
+}
+
+// isLowerFollowing reports whether the uppercase letter of the current unit, which continues a
+// sequence of uppercase letters, is followed by a lowercase letter other than a plural suffix, so
+// the letter starts a new word.
+func (s *wordScanner) isLowerFollowing() bool {
+	if !s.opts.separatesAcronyms() || s.pos >= len(s.input) {
+		return false
+	}
+	ch, size := utf8.DecodeRuneInString(s.input[s.pos:])
+	if !isLowerCase(ch, s.opts.isUnicode()) {
+		return false
+	}
+	end := s.pos + size
+	if s.opts.isUnicode() {
+		end = s.pos + graphemeClusterLen(s.input[s.pos:])
+	}
+	return !isPluralSuffix(s.input, s.runStart, s.pos, end, s.opts)
+}
+
+// wordEnd returns the byte offset of the end of the word which starts at the current unit, and
+// whether the word has uppercase letters but no lowercase letters.
+func (s *wordScanner) wordEnd() (int, bool) {
+	t := *s
+	end := s.end
+	hasUpper := s.class == charIsUpper
+	hasLower := s.class == charIsLower
+	for t.scan() && !t.newWord && t.class != charIsSepMark {
+		end = t.end
+		hasUpper = hasUpper || t.class == charIsUpper
+		hasLower = hasLower || t.class == charIsLower
+	}
+	return end, hasUpper && !hasLower
+}
+
+// lastWordStart returns the byte offset of the head of the last word in the input string, or -1
+// if there are no words.
+func (s *wordScanner) lastWordStart() int {
+	t := *s
+	start := -1
+	for t.scan() {
+		if t.class != charIsSepMark && (t.newWord || start < 0) {
+			start = t.start
+		}
+	}
+	return start
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// frequentScripts is the scripts checked first when finding the script of a letter, before all
+// the scripts in unicode.Scripts.
+var frequentScripts = [...]*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Katakana,
+	unicode.Hangul,
+	unicode.Greek,
+	unicode.Cyrillic,
+}
+
+// scriptOf returns the script of the letter, or nil if the letter belongs to the Common or
+// Inherited script, which takes over the script of the preceding letter.
+func scriptOf(ch rune) *unicode.RangeTable {
+	if ch < utf8.RuneSelf {
+		return unicode.Latin
+	}
+	if unicode.In(ch, unicode.Common, unicode.Inherited) {
+		return nil
+	}
+	for _, script := range frequentScripts {
+		if unicode.Is(script, ch) {
+			return script
+		}
+	}
+	var found *unicode.RangeTable
+	for _, script := range unicode.Scripts {
+		if unicode.Is(script, ch) {
+			found = script
+			break
+		}
+	}
+	return found
+}
@@ -0,0 +1,145 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestSeparateScripts(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Unicode:                    true,
+		SeparateScripts:            true,
+	}
+
+	t.Run("split between Latin and Japanese", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.KebabCaseWithOptions("APIキー", opts)
+		assert.Equal(t, result, "api-キー")
+
+		result = stringcase.SnakeCaseWithOptions("ユーザーID一覧", opts)
+		assert.Equal(t, result, "ユーザー_id_一覧")
+
+		result = stringcase.CamelCaseWithOptions("ユーザーID一覧", opts)
+		assert.Equal(t, result, "ユーザーId一覧")
+
+		result = stringcase.PascalCaseWithOptions("apiキーの値", opts)
+		assert.Equal(t, result, "Apiキーの値")
+
+		result = stringcase.MacroCaseWithOptions("キーid", opts)
+		assert.Equal(t, result, "キー_ID")
+
+		result = stringcase.MacroCaseWithOptions("API_キー", opts)
+		assert.Equal(t, result, "API_キー")
+
+		result = stringcase.TitleCaseWithOptions("API キー", opts)
+		assert.Equal(t, result, "Api キー")
+	})
+
+	t.Run("split between Han, Hiragana, Katakana, and Hangul", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("漢字かなカナ한글", opts)
+		assert.Equal(t, result, "漢字_かな_カナ_한글")
+
+		result = stringcase.KebabCaseWithOptions("한국어Text", opts)
+		assert.Equal(t, result, "한국어-text")
+	})
+
+	t.Run("split between scripts with case", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("abcαβγабв", opts)
+		assert.Equal(t, result, "abc_αβγ_абв")
+
+		result = stringcase.SnakeCaseWithOptions("ΑΒΓDelta", opts)
+		assert.Equal(t, result, "αβγ_delta")
+
+		result = stringcase.MacroCaseWithOptions("ΑΒΓDELTA", opts)
+		assert.Equal(t, result, "ΑΒΓ_DELTA")
+
+		result = stringcase.SnakeCaseWithOptions("ΟΔΟΣXYZ", opts)
+		assert.Equal(t, result, "οδος_xyz")
+	})
+
+	t.Run("uppercase letters followed by lowercase letters of another script", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("ABCабв", opts)
+		assert.Equal(t, result, "ab_c_абв")
+
+		result = stringcase.MacroCaseWithOptions("ABCабв", opts)
+		assert.Equal(t, result, "AB_C_АБВ")
+
+		result = stringcase.TrainCaseWithOptions("ABCабв", opts)
+		assert.Equal(t, result, "Ab-C-Абв")
+
+		result = stringcase.CamelCaseWithOptions("ABCабв", opts)
+		assert.Equal(t, result, "abCАбв")
+
+		result = stringcase.PascalCaseWithOptions("ABCабв", opts)
+		assert.Equal(t, result, "AbCАбв")
+	})
+
+	t.Run("continue the script with Common and Inherited letters", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("データー", opts)
+		assert.Equal(t, result, "データー")
+
+		result = stringcase.SnakeCaseWithOptions("ｰキー", opts)
+		assert.Equal(t, result, "ｰキー")
+	})
+
+	t.Run("with digits and separators", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("キー2値", opts)
+		assert.Equal(t, result, "キー2_値")
+
+		result = stringcase.SnakeCaseWithOptions("2キーAPI", opts)
+		assert.Equal(t, result, "2_キー_api")
+
+		result = stringcase.SnakeCaseWithOptions("キー・値", opts)
+		assert.Equal(t, result, "キー_値")
+
+		opts.SeparateBeforeNonAlphabets = true
+		result = stringcase.SnakeCaseWithOptions("キー2値", opts)
+		assert.Equal(t, result, "キー_2_値")
+	})
+
+	t.Run("letters of rare scripts", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.SnakeCaseWithOptions("abcअनुच्छेद", opts)
+		assert.Equal(t, result, "abc_अनुच्छेद")
+	})
+
+	t.Run("without the option", func(t *testing.T) {
+		opts := origOpts
+		opts.SeparateScripts = false
+
+		result := stringcase.KebabCaseWithOptions("APIキー", opts)
+		assert.Equal(t, result, "api")
+
+		opts.Keep = "キー"
+		result = stringcase.KebabCaseWithOptions("APIキー", opts)
+		assert.Equal(t, result, "apiキー")
+	})
+
+	t.Run("imply the Unicode option", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = false
+
+		result := stringcase.KebabCaseWithOptions("APIキー", opts)
+		assert.Equal(t, result, "api-キー")
+
+		result = stringcase.PascalCaseWithOptions("ÉtéキーΣΑΣ", opts)
+		assert.Equal(t, result, "ÉtéキーΣας")
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SentenceCaseWithOptions converts the input string to sentence case with the
+// specified options.
+//
+// Only the first letter of the first word is capitalized, the other letters
+// are lowercased, and the words are joined with spaces. The words which match
+// opts.Acronyms or opts.ProtectedWords keep their styles, like
+// "Connect to GitHub via SSH". When the separators between words include the
+// sentence terminators '.', '?', or '!' followed by whitespace, the
+// terminators are kept and the next word is capitalized as the head of a new
+// sentence. The terminators following the last word are also kept.
+func SentenceCaseWithOptions(input string, opts Options) string {
+	input = opts.preprocess(input)
+	buf := make([]byte, 0, len(input)+len(input)/2)
+	return string(appendSentence(buf, input, &opts))
+}
+
+// SentenceCase converts the input string to sentence case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func SentenceCase(input string) string {
+	return SentenceCaseWithOptions(input, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendSentenceCase appends the input string converted to sentence case to dst
+// and returns the extended buffer, in the same way as SentenceCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendSentenceCase(dst []byte, input string) []byte {
+	opts := Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+	return appendSentence(dst, input, &opts)
+}
+
+// appendSentence appends the preprocessed input string converted to sentence case to dst.
+func appendSentence(dst []byte, input string, opts *Options) []byte {
+	return appendWords(dst, input, opts, WordCaseTitle, WordCaseLower, " ", nil, sentenceRules{})
+}
+
+type sentenceRules struct{}
+
+func (sentenceRules) head(
+	seps, word, rest string, isFirst, isLast bool,
+) (string, string, WordCase) {
+	if isFirst {
+		return "", "", WordCaseTitle
+	}
+	if t, ok := findSentenceTerminators(seps); ok {
+		return t, " ", WordCaseTitle
+	}
+	return "", " ", WordCaseLower
+}
+
+func (sentenceRules) tail(seps string) string {
+	t, _ := findSentenceTerminators(seps)
+	return t
+}
+
+// findSentenceTerminators returns the first sequence of the sentence terminators in the separators,
+// and reports whether it is followed by whitespace.
+func findSentenceTerminators(seps string) (string, bool) {
+	i := strings.IndexAny(seps, ".?!")
+	if i < 0 {
+		return "", false
+	}
+	j := i + 1
+	for j < len(seps) && strings.IndexByte(".?!", seps[j]) >= 0 {
+		j++
+	}
+	return seps[i:j], strings.IndexFunc(seps[j:], unicode.IsSpace) >= 0
+}
+
+// IsSentenceCaseWithOptions reports whether the input string is in sentence case
+// with the specified options, that is, whether SentenceCaseWithOptions
+// returns it unchanged.
+func IsSentenceCaseWithOptions(input string, opts Options) bool {
+	return SentenceCaseWithOptions(input, opts) == input
+}
+
+// IsSentenceCase reports whether the input string is in sentence case.
+func IsSentenceCase(input string) bool {
+	return SentenceCase(input) == input
+}
@@ -0,0 +1,157 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestSentenceCase(t *testing.T) {
+	t.Run("convert camelCase", func(t *testing.T) {
+		result := stringcase.SentenceCase("userAccountSettings")
+		assert.Equal(t, result, "User account settings")
+	})
+
+	t.Run("convert snake_case", func(t *testing.T) {
+		result := stringcase.SentenceCase("user_account_settings")
+		assert.Equal(t, result, "User account settings")
+	})
+
+	t.Run("convert MACRO_CASE", func(t *testing.T) {
+		result := stringcase.SentenceCase("USER_ACCOUNT_SETTINGS")
+		assert.Equal(t, result, "User account settings")
+	})
+
+	t.Run("convert Title Case", func(t *testing.T) {
+		result := stringcase.SentenceCase("User Account Settings")
+		assert.Equal(t, result, "User account settings")
+	})
+
+	t.Run("convert with keeping digits", func(t *testing.T) {
+		result := stringcase.SentenceCase("retry3Times")
+		assert.Equal(t, result, "Retry3 times")
+	})
+
+	t.Run("convert multiple sentences", func(t *testing.T) {
+		result := stringcase.SentenceCase("hello world. how are you?")
+		assert.Equal(t, result, "Hello world. How are you?")
+
+		result = stringcase.SentenceCase("  FILE NOT FOUND!! try AGAIN...")
+		assert.Equal(t, result, "File not found!! Try again...")
+
+		result = stringcase.SentenceCase("config.file.path")
+		assert.Equal(t, result, "Config file path")
+
+		result = stringcase.SentenceCase("wait. (really)")
+		assert.Equal(t, result, "Wait. Really")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.SentenceCase("")
+		assert.Equal(t, result, "")
+
+		result = stringcase.SentenceCase("?!")
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestSentenceCaseWithOptions(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("keep acronyms and protected words", func(t *testing.T) {
+		opts := origOpts
+		opts.Acronyms = stringcase.NewWordList("SSH", "ID")
+		opts.ProtectedWords = stringcase.NewWordList("GitHub", "iOS")
+
+		result := stringcase.SentenceCaseWithOptions("connect_to_github_via_ssh", opts)
+		assert.Equal(t, result, "Connect to GitHub via SSH")
+
+		result = stringcase.SentenceCaseWithOptions("ios app. enter your ssh key", opts)
+		assert.Equal(t, result, "iOS app. Enter your SSH key")
+
+		opts.AcronymPlurals = true
+		result = stringcase.SentenceCaseWithOptions("list user IDs", opts)
+		assert.Equal(t, result, "List user IDs")
+
+		opts.AcronymStyle = stringcase.AcronymStyleCapitalize
+		result = stringcase.SentenceCaseWithOptions("SSH KEY", opts)
+		assert.Equal(t, result, "Ssh key")
+	})
+
+	t.Run("non-alphabets as head of a word", func(t *testing.T) {
+		opts := origOpts
+		opts.SeparateBeforeNonAlphabets = true
+		opts.SeparateAfterNonAlphabets = false
+
+		result := stringcase.SentenceCaseWithOptions("retry3Times", opts)
+		assert.Equal(t, result, "Retry 3 times")
+	})
+
+	t.Run("with other options", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+
+		result := stringcase.SentenceCaseWithOptions("ΚΑΛΗΜΕΡΑ ΚΟΣΜΕ. ΤΙ ΚΑΝΕΙΣ;", opts)
+		assert.Equal(t, result, "Καλημερα κοσμε. Τι κανεις")
+
+		opts.Keep = "#"
+		result = stringcase.SentenceCaseWithOptions("use_c#_here", opts)
+		assert.Equal(t, result, "Use c# here")
+	})
+}
+
+func TestIsSentenceCase(t *testing.T) {
+	t.Run("string in sentence case", func(t *testing.T) {
+		assert.True(t, stringcase.IsSentenceCase("User account settings"))
+		assert.True(t, stringcase.IsSentenceCase(""))
+	})
+
+	t.Run("string not in sentence case", func(t *testing.T) {
+		assert.False(t, stringcase.IsSentenceCase("user_account_settings"))
+		assert.False(t, stringcase.IsSentenceCase("User account settings_"))
+		assert.False(t, stringcase.IsSentenceCase("Rate% limit"))
+	})
+}
+
+func TestIsSentenceCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in sentence case", func(t *testing.T) {
+		assert.True(t, stringcase.IsSentenceCaseWithOptions("Rate% limit", opts))
+		assert.True(t, stringcase.IsSentenceCaseWithOptions("User account settings", opts))
+	})
+
+	t.Run("string not in sentence case", func(t *testing.T) {
+		assert.False(t, stringcase.IsSentenceCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsSentenceCaseWithOptions("User account settings_", opts))
+	})
+}
+
+func TestAppendSentenceCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendSentenceCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=User account settings")
+
+		buf = stringcase.AppendSentenceCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "User account settings")
+	})
+
+	t.Run("same result as SentenceCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendSentenceCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.SentenceCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendSentenceCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -7,7 +7,14 @@ package stringcase
 // SnakeCaseWithOptions converts the input string to snake case with the
 // specified options.
 func SnakeCaseWithOptions(input string, opts Options) string {
-	return Lowerize(input, '_', opts)
+	return Format(input, styleSnake, opts)
+}
+
+// SnakeCaseWithMapping converts the input string to snake case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func SnakeCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleSnake, opts)
 }
 
 // SnakeCase converts the input string to snake case.
@@ -15,8 +22,30 @@ func SnakeCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func SnakeCase(input string) string {
-	return Lowerize(input, '_', Options{
+	return Format(input, styleSnake, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// AppendSnakeCase appends the input string converted to snake case to dst
+// and returns the extended buffer, in the same way as SnakeCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendSnakeCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleSnake, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsSnakeCaseWithOptions reports whether the input string is in snake case
+// with the specified options, that is, whether SnakeCaseWithOptions
+// returns it unchanged.
+func IsSnakeCaseWithOptions(input string, opts Options) bool {
+	return SnakeCaseWithOptions(input, opts) == input
+}
+
+// IsSnakeCase reports whether the input string is in snake case.
+func IsSnakeCase(input string) bool {
+	return SnakeCase(input) == input
+}
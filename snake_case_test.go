@@ -1262,3 +1262,82 @@ func TestSnakeCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestSnakeCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.SnakeCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "a_b_c1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.SnakeCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsSnakeCase(t *testing.T) {
+	t.Run("string in snake case", func(t *testing.T) {
+		assert.True(t, stringcase.IsSnakeCase("user_account_settings"))
+		assert.True(t, stringcase.IsSnakeCase(""))
+	})
+
+	t.Run("string not in snake case", func(t *testing.T) {
+		assert.False(t, stringcase.IsSnakeCase("user-account-settings"))
+		assert.False(t, stringcase.IsSnakeCase("_user_account_settings"))
+		assert.False(t, stringcase.IsSnakeCase("rate%_limit"))
+	})
+}
+
+func TestIsSnakeCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in snake case", func(t *testing.T) {
+		assert.True(t, stringcase.IsSnakeCaseWithOptions("rate%_limit", opts))
+		assert.True(t, stringcase.IsSnakeCaseWithOptions("user_account_settings", opts))
+	})
+
+	t.Run("string not in snake case", func(t *testing.T) {
+		assert.False(t, stringcase.IsSnakeCaseWithOptions("user-account-settings", opts))
+		assert.False(t, stringcase.IsSnakeCaseWithOptions("_user_account_settings", opts))
+	})
+}
+
+func TestAppendSnakeCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendSnakeCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=user_account_settings")
+
+		buf = stringcase.AppendSnakeCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "user_account_settings")
+	})
+
+	t.Run("same result as SnakeCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendSnakeCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.SnakeCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendSnakeCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// specialCasing is a case mapping of a character which is mapped to more than one character in
+// lowercase, titlecase, or uppercase.
+type specialCasing struct {
+	ch    rune
+	lower string
+	title string
+	upper string
+}
+
+const minSpecialCasing = 0x00df
+
+func findSpecialCasing(ch rune) *specialCasing {
+	if ch < minSpecialCasing {
+		return nil
+	}
+	i := sort.Search(len(specialCasings), func(i int) bool {
+		return specialCasings[i].ch >= ch
+	})
+	if i < len(specialCasings) && specialCasings[i].ch == ch {
+		return &specialCasings[i]
+	}
+	return nil
+}
+
+const (
+	capitalSigma rune = 0x03a3
+	finalSigma   rune = 0x03c2
+)
+
+// isFinalSigma reports whether a capital sigma, which is lowercased following a letter of the same
+// word, is at the end of the word, so it is lowercased to final sigma. The sigma is the last of a
+// sequence of uppercase letters starting at runStart, and its grapheme cluster ends at end. It is
+// at the end of the word unless it is followed by a lowercase letter, or by an uppercase letter
+// which does not start a new word before a lowercase letter, of the same script if
+// opts.SeparateScripts is true.
+func isFinalSigma(input string, runStart, end int, opts *Options) bool {
+	ch, _ := utf8.DecodeRuneInString(input[end:])
+	isUpper, isLower := isUnicodeUpperCase(ch), isUnicodeLowerCase(ch)
+	if opts.SeparateScripts && (isUpper || isLower) {
+		if script := scriptOf(ch); script != nil && script != unicode.Greek {
+			return true
+		}
+	}
+	if isLower {
+		return false
+	}
+	if !isUpper {
+		return true
+	}
+	if !opts.separatesAcronyms() {
+		return false
+	}
+	next := end + graphemeClusterLen(input[end:])
+	ch, _ = utf8.DecodeRuneInString(input[next:])
+	if !isUnicodeLowerCase(ch) {
+		return false
+	}
+	return !isPluralSuffix(input, runStart, next, next+graphemeClusterLen(input[next:]), opts)
+}
@@ -0,0 +1,107 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestSpecialCasing(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Unicode:                    true,
+	}
+
+	t.Run("German sharp s", func(t *testing.T) {
+		result := stringcase.MacroCaseWithOptions("straße", opts)
+		assert.Equal(t, result, "STRASSE")
+
+		result = stringcase.CobolCaseWithOptions("großeStraße", opts)
+		assert.Equal(t, result, "GROSSE-STRASSE")
+
+		result = stringcase.Upperize("straße", '.', opts)
+		assert.Equal(t, result, "STRASSE")
+
+		result = stringcase.SnakeCaseWithOptions("straße", opts)
+		assert.Equal(t, result, "straße")
+
+		result = stringcase.PascalCaseWithOptions("straße_ßtraße", opts)
+		assert.Equal(t, result, "StraßeSstraße")
+	})
+
+	t.Run("ligatures", func(t *testing.T) {
+		result := stringcase.MacroCaseWithOptions("ﬁle_ﬂow", opts)
+		assert.Equal(t, result, "FILE_FLOW")
+
+		result = stringcase.PascalCaseWithOptions("ﬁle_ﬂow", opts)
+		assert.Equal(t, result, "FileFlow")
+
+		result = stringcase.TitleCaseWithOptions("ﬃ_ﬆ", opts)
+		assert.Equal(t, result, "Ffi St")
+
+		result = stringcase.KebabCaseWithOptions("ﬁle_ﬂow", opts)
+		assert.Equal(t, result, "ﬁle-ﬂow")
+	})
+
+	t.Run("Greek final sigma", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("ΟΔΟΣ_ΟΔΟΣ", opts)
+		assert.Equal(t, result, "οδος_οδος")
+
+		result = stringcase.Lowerize("ΣΑΣ", '.', opts)
+		assert.Equal(t, result, "σας")
+
+		result = stringcase.PascalCaseWithOptions("ΟΔΟΣ_ΟΔΟΣ", opts)
+		assert.Equal(t, result, "ΟδοςΟδος")
+
+		result = stringcase.CamelCaseWithOptions("ΟΔΟΣ_ΟΔΟΣ", opts)
+		assert.Equal(t, result, "οδοςΟδος")
+
+		result = stringcase.TitleCaseWithOptions("ΌΣΟΣOk", opts)
+		assert.Equal(t, result, "Όσος Ok")
+
+		result = stringcase.SnakeCaseWithOptions("ΑΣ1", opts)
+		assert.Equal(t, result, "ας1")
+	})
+
+	t.Run("Greek capital sigma not at the end of a word", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("ΟΔΟΣΟΔΟΣ", opts)
+		assert.Equal(t, result, "οδοσοδος")
+
+		result = stringcase.SnakeCaseWithOptions("Σ_ΑΣ", opts)
+		assert.Equal(t, result, "σ_ας")
+
+		result = stringcase.SnakeCaseWithOptions("1Σ", opts)
+		assert.Equal(t, result, "1_σ")
+
+		result = stringcase.SnakeCaseWithOptions("ΑΣαν", opts)
+		assert.Equal(t, result, "α_σαν")
+
+		result = stringcase.MacroCaseWithOptions("οδος", opts)
+		assert.Equal(t, result, "ΟΔΟΣ")
+	})
+
+	t.Run("other mappings to more than one character", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("İstanbul", opts)
+		assert.Equal(t, result, "i̇stanbul")
+
+		result = stringcase.MacroCaseWithOptions("ǰ", opts)
+		assert.Equal(t, result, "J̌")
+
+		result = stringcase.TrainCaseWithOptions("ᾳ_ᾼ", opts)
+		assert.Equal(t, result, "ᾼ-ᾼ")
+
+		result = stringcase.MacroCaseWithOptions("ᾳ_ᾼ", opts)
+		assert.Equal(t, result, "ΑΙ_ΑΙ")
+	})
+
+	t.Run("no effect without the Unicode option", func(t *testing.T) {
+		o := opts
+		o.Unicode = false
+
+		result := stringcase.MacroCaseWithOptions("straße", o)
+		assert.Equal(t, result, "STRA_E")
+	})
+}
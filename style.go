@@ -0,0 +1,179 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// WordCase is the casing applied to the letters of a word.
+type WordCase uint8
+
+const (
+	// WordCaseLower converts all the letters of a word to lowercase.
+	WordCaseLower WordCase = iota
+
+	// WordCaseUpper converts all the letters of a word to uppercase.
+	WordCaseUpper
+
+	// WordCaseTitle converts the first letter of a word to titlecase and the others to lowercase.
+	// Words which match Options.Acronyms and Options.ProtectedWords are written in the styles
+	// specified in Options instead.
+	WordCaseTitle
+)
+
+// Style is a description of a case style.
+//
+// The First field specifies the casing of the first word, and the Rest field that of the other
+// words. The Joiner field is inserted between words, and can be any string including an empty
+// string and a string of multiple runes. The Prefix and Suffix fields are added at the head and
+// the tail of the result even when the input string has no words.
+type Style struct {
+	First  WordCase
+	Rest   WordCase
+	Joiner string
+	Prefix string
+	Suffix string
+}
+
+// The predefined styles of the case conversion functions, which are returned as copies by the
+// Style〜 functions.
+var (
+	styleAda    = Style{First: WordCaseTitle, Rest: WordCaseTitle, Joiner: "_"}
+	styleCamel  = Style{First: WordCaseLower, Rest: WordCaseTitle}
+	styleCobol  = Style{First: WordCaseUpper, Rest: WordCaseUpper, Joiner: "-"}
+	styleKebab  = Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: "-"}
+	styleMacro  = Style{First: WordCaseUpper, Rest: WordCaseUpper, Joiner: "_"}
+	stylePascal = Style{First: WordCaseTitle, Rest: WordCaseTitle}
+	styleSnake  = Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: "_"}
+	styleTitle  = Style{First: WordCaseTitle, Rest: WordCaseTitle, Joiner: " "}
+	styleTrain  = Style{First: WordCaseTitle, Rest: WordCaseTitle, Joiner: "-"}
+
+	styleDot        = Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: "."}
+	stylePath       = Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: "/"}
+	styleFlat       = Style{First: WordCaseLower, Rest: WordCaseLower}
+	styleUpperFlat  = Style{First: WordCaseUpper, Rest: WordCaseUpper}
+	styleCamelSnake = Style{First: WordCaseLower, Rest: WordCaseTitle, Joiner: "_"}
+	styleLowerSpace = Style{First: WordCaseLower, Rest: WordCaseLower, Joiner: " "}
+	styleUpperSpace = Style{First: WordCaseUpper, Rest: WordCaseUpper, Joiner: " "}
+)
+
+// StyleAda returns the style of Ada_Case.
+func StyleAda() Style {
+	return styleAda
+}
+
+// StyleCamel returns the style of camelCase.
+func StyleCamel() Style {
+	return styleCamel
+}
+
+// StyleCobol returns the style of COBOL-CASE.
+func StyleCobol() Style {
+	return styleCobol
+}
+
+// StyleKebab returns the style of kebab-case.
+func StyleKebab() Style {
+	return styleKebab
+}
+
+// StyleMacro returns the style of MACRO_CASE.
+func StyleMacro() Style {
+	return styleMacro
+}
+
+// StylePascal returns the style of PascalCase.
+func StylePascal() Style {
+	return stylePascal
+}
+
+// StyleSnake returns the style of snake_case.
+func StyleSnake() Style {
+	return styleSnake
+}
+
+// StyleTitle returns the style of Title Case.
+func StyleTitle() Style {
+	return styleTitle
+}
+
+// StyleTrain returns the style of Train-Case.
+func StyleTrain() Style {
+	return styleTrain
+}
+
+// StyleDot returns the style of dot.case.
+func StyleDot() Style {
+	return styleDot
+}
+
+// StylePath returns the style of path/case.
+func StylePath() Style {
+	return stylePath
+}
+
+// StyleFlat returns the style of flatcase.
+func StyleFlat() Style {
+	return styleFlat
+}
+
+// StyleUpperFlat returns the style of UPPERFLATCASE.
+func StyleUpperFlat() Style {
+	return styleUpperFlat
+}
+
+// StyleCamelSnake returns the style of camel_Snake_Case.
+func StyleCamelSnake() Style {
+	return styleCamelSnake
+}
+
+// StyleLowerSpace returns the style of lower space case.
+func StyleLowerSpace() Style {
+	return styleLowerSpace
+}
+
+// StyleUpperSpace returns the style of UPPER SPACE CASE.
+func StyleUpperSpace() Style {
+	return styleUpperSpace
+}
+
+// AppendFormat appends the input string converted to the case style with the options to dst and
+// returns the extended buffer, in the same way as Format. Like strconv.AppendInt, it does not
+// allocate memory when dst has enough capacity, unless opts.Normalization or opts.FoldToASCII
+// changes the input string.
+func AppendFormat(dst []byte, input string, style Style, opts Options) []byte {
+	input = opts.preprocess(input)
+	return appendStyle(dst, input, &style, &opts, nil)
+}
+
+// Format converts the input string to the case style with the options.
+//
+// The input string is split into words with the same rules as the other conversion functions,
+// and the words are cased and joined as specified in style.
+func Format(input string, style Style, opts Options) string {
+	input = opts.preprocess(input)
+	buf := make([]byte, 0, len(style.Prefix)+len(input)+len(input)/2+len(style.Suffix))
+	return string(appendStyle(buf, input, &style, &opts, nil))
+}
+
+// FormatWithMapping converts the input string in the same way as Format, and also returns the
+// spans which map the result to the input string. The prefix and the suffix are mapped to the
+// empty ranges at the head and the tail of the input string.
+func FormatWithMapping(input string, style Style, opts Options) (string, []Span) {
+	input, offsets := opts.preprocessWithOffsets(input)
+	r := newSpanRecorder(len(input) + 2)
+	buf := make([]byte, 0, len(style.Prefix)+len(input)+len(input)/2+len(style.Suffix))
+	return r.finish(appendStyle(buf, input, &style, &opts, r), offsets)
+}
+
+func appendStyle(dst []byte, input string, style *Style, opts *Options, r *spanRecorder) []byte {
+	if len(style.Prefix) > 0 {
+		dst = append(dst, style.Prefix...)
+		r.add(len(dst), 0, 0)
+	}
+	dst = appendWords(dst, input, opts, style.First, style.Rest, style.Joiner, r, nil)
+	if len(style.Suffix) > 0 {
+		dst = append(dst, style.Suffix...)
+		r.add(len(dst), len(input), len(input))
+	}
+	return dst
+}
@@ -0,0 +1,217 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestFormat(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("custom styles", func(t *testing.T) {
+		style := stringcase.Style{
+			First:  stringcase.WordCaseLower,
+			Rest:   stringcase.WordCaseTitle,
+			Joiner: ".",
+		}
+		result := stringcase.Format("foo_bar_baz", style, origOpts)
+		assert.Equal(t, result, "foo.Bar.Baz")
+
+		style = stringcase.Style{
+			First:  stringcase.WordCaseTitle,
+			Rest:   stringcase.WordCaseTitle,
+			Joiner: "::",
+		}
+		result = stringcase.Format("std-io-Reader", style, origOpts)
+		assert.Equal(t, result, "Std::Io::Reader")
+
+		style = stringcase.Style{
+			First:  stringcase.WordCaseUpper,
+			Rest:   stringcase.WordCaseLower,
+			Joiner: "__",
+		}
+		result = stringcase.Format("fooBar100Baz", style, origOpts)
+		assert.Equal(t, result, "FOO__bar100__baz")
+	})
+
+	t.Run("prefix and suffix", func(t *testing.T) {
+		style := stringcase.Style{
+			First:  stringcase.WordCaseLower,
+			Rest:   stringcase.WordCaseLower,
+			Joiner: "_",
+			Prefix: "__",
+			Suffix: "__",
+		}
+		result := stringcase.Format("Init", style, origOpts)
+		assert.Equal(t, result, "__init__")
+
+		result = stringcase.Format("-", style, origOpts)
+		assert.Equal(t, result, "____")
+	})
+
+	t.Run("predefined styles", func(t *testing.T) {
+		input := "--fooBar100%bazQux--"
+		opts := origOpts
+		opts.SeparateBeforeNonAlphabets = true
+
+		result := stringcase.Format(input, stringcase.StyleAda(), opts)
+		assert.Equal(t, result, stringcase.AdaCaseWithOptions(input, opts))
+		assert.Equal(t, result, "Foo_Bar_100_Baz_Qux")
+
+		result = stringcase.Format(input, stringcase.StyleCamel(), opts)
+		assert.Equal(t, result, stringcase.CamelCaseWithOptions(input, opts))
+		assert.Equal(t, result, "fooBar100BazQux")
+
+		result = stringcase.Format(input, stringcase.StyleCobol(), opts)
+		assert.Equal(t, result, stringcase.CobolCaseWithOptions(input, opts))
+		assert.Equal(t, result, "FOO-BAR-100-BAZ-QUX")
+
+		result = stringcase.Format(input, stringcase.StyleKebab(), opts)
+		assert.Equal(t, result, stringcase.KebabCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foo-bar-100-baz-qux")
+
+		result = stringcase.Format(input, stringcase.StyleMacro(), opts)
+		assert.Equal(t, result, stringcase.MacroCaseWithOptions(input, opts))
+		assert.Equal(t, result, "FOO_BAR_100_BAZ_QUX")
+
+		result = stringcase.Format(input, stringcase.StylePascal(), opts)
+		assert.Equal(t, result, stringcase.PascalCaseWithOptions(input, opts))
+		assert.Equal(t, result, "FooBar100BazQux")
+
+		result = stringcase.Format(input, stringcase.StyleSnake(), opts)
+		assert.Equal(t, result, stringcase.SnakeCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foo_bar_100_baz_qux")
+
+		result = stringcase.Format(input, stringcase.StyleTitle(), opts)
+		assert.Equal(t, result, stringcase.TitleCaseWithOptions(input, opts))
+		assert.Equal(t, result, "Foo Bar 100 Baz Qux")
+
+		result = stringcase.Format(input, stringcase.StyleTrain(), opts)
+		assert.Equal(t, result, stringcase.TrainCaseWithOptions(input, opts))
+		assert.Equal(t, result, "Foo-Bar-100-Baz-Qux")
+
+		result = stringcase.Format(input, stringcase.StyleDot(), opts)
+		assert.Equal(t, result, stringcase.DotCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foo.bar.100.baz.qux")
+
+		result = stringcase.Format(input, stringcase.StylePath(), opts)
+		assert.Equal(t, result, stringcase.PathCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foo/bar/100/baz/qux")
+
+		result = stringcase.Format(input, stringcase.StyleFlat(), opts)
+		assert.Equal(t, result, stringcase.FlatCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foobar100bazqux")
+
+		result = stringcase.Format(input, stringcase.StyleUpperFlat(), opts)
+		assert.Equal(t, result, stringcase.UpperFlatCaseWithOptions(input, opts))
+		assert.Equal(t, result, "FOOBAR100BAZQUX")
+
+		result = stringcase.Format(input, stringcase.StyleCamelSnake(), opts)
+		assert.Equal(t, result, stringcase.CamelSnakeCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foo_Bar_100_Baz_Qux")
+
+		result = stringcase.Format(input, stringcase.StyleLowerSpace(), opts)
+		assert.Equal(t, result, stringcase.LowerSpaceCaseWithOptions(input, opts))
+		assert.Equal(t, result, "foo bar 100 baz qux")
+
+		result = stringcase.Format(input, stringcase.StyleUpperSpace(), opts)
+		assert.Equal(t, result, stringcase.UpperSpaceCaseWithOptions(input, opts))
+		assert.Equal(t, result, "FOO BAR 100 BAZ QUX")
+	})
+
+	t.Run("modify a copy of a predefined style", func(t *testing.T) {
+		style := stringcase.StyleSnake()
+		style.Joiner = "-"
+		assert.Equal(t, stringcase.Format("fooBar", style, origOpts), "foo-bar")
+		assert.Equal(t, stringcase.StyleSnake().Joiner, "_")
+		assert.Equal(t, stringcase.SnakeCase("fooBar"), "foo_bar")
+	})
+
+	t.Run("with other options", func(t *testing.T) {
+		opts := origOpts
+		opts.Acronyms = stringcase.NewWordList(stringcase.GoInitialisms()...)
+		style := stringcase.Style{
+			First:  stringcase.WordCaseLower,
+			Rest:   stringcase.WordCaseTitle,
+			Joiner: ".",
+		}
+		result := stringcase.Format("http_server_id", style, opts)
+		assert.Equal(t, result, "http.Server.ID")
+
+		opts = origOpts
+		opts.Unicode = true
+		opts.FoldToASCII = true
+		result = stringcase.Format("Crème Brûlée", style, opts)
+		assert.Equal(t, result, "creme.Brulee")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.Format("", stringcase.StyleSnake(), origOpts)
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestFormatWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the prefix and the suffix", func(t *testing.T) {
+		style := stringcase.Style{
+			First:  stringcase.WordCaseLower,
+			Rest:   stringcase.WordCaseLower,
+			Joiner: "::",
+			Prefix: "<",
+			Suffix: ">",
+		}
+		result, spans := stringcase.FormatWithMapping("aB-", style, opts)
+		assert.Equal(t, result, "<a::b>")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 1, InStart: 0, InEnd: 0},
+			{OutStart: 1, OutEnd: 2, InStart: 0, InEnd: 1},
+			{OutStart: 2, OutEnd: 4, InStart: 1, InEnd: 1},
+			{OutStart: 4, OutEnd: 5, InStart: 1, InEnd: 2},
+			{OutStart: 5, OutEnd: 5, InStart: 2, InEnd: 3},
+			{OutStart: 5, OutEnd: 6, InStart: 3, InEnd: 3},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.FormatWithMapping("", stringcase.StyleSnake(), opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestAppendFormat(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+
+	t.Run("append to a buffer", func(t *testing.T) {
+		style := stringcase.Style{First: stringcase.WordCaseUpper, Rest: stringcase.WordCaseTitle, Joiner: "::", Prefix: "<", Suffix: ">"}
+		buf := stringcase.AppendFormat([]byte("key="), "userAccount-settings", style, opts)
+		assert.Equal(t, string(buf), "key=<USER::Account::Settings>")
+	})
+
+	t.Run("same result as Format", func(t *testing.T) {
+		nfd := stringcase.Options{Unicode: true, Normalization: stringcase.NormalizationNFD, Acronyms: stringcase.NewWordList("ID")}
+		input := "Cr\u00e8me_id Br\u00fbl\u00e9e"
+		buf := stringcase.AppendFormat(nil, input, stringcase.StylePascal(), nfd)
+		assert.Equal(t, string(buf), stringcase.Format(input, stringcase.StylePascal(), nfd))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		acronyms := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%", Acronyms: stringcase.NewWordList(stringcase.GoInitialisms()...)}
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendFormat(buf[:0], "userId-settings. fooBar100%baz", stringcase.StyleTrain(), acronyms)
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
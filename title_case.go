@@ -6,8 +6,38 @@ package stringcase
 
 // TitleCaseWithOptions converts the input string to title case with the
 // specified options.
+//
+// If opts.TitleStyle or opts.MinorWords is specified, the minor words, such
+// as articles, short conjunctions, and prepositions, are lowercased except
+// at the start and end of the title, like "The Lord of the Rings". In this
+// case, a colon or sentence terminators followed by whitespace are kept and
+// the next word is capitalized, and a hyphen between words in an input string
+// including whitespace is kept in a hyphenated compound, like
+// "State-of-the-Art".
 func TitleCaseWithOptions(input string, opts Options) string {
-	return Capitalize(input, ' ', opts)
+	if !opts.hasTitleRules() {
+		return Format(input, styleTitle, opts)
+	}
+	input = opts.preprocess(input)
+	buf := make([]byte, 0, len(input)+len(input)/2)
+	buf = appendWords(buf, input, &opts, WordCaseTitle, WordCaseTitle, " ", nil,
+		newTitleRules(input, opts.titleMinorWords()))
+	return string(buf)
+}
+
+// TitleCaseWithMapping converts the input string to title case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func TitleCaseWithMapping(input string, opts Options) (string, []Span) {
+	if !opts.hasTitleRules() {
+		return FormatWithMapping(input, styleTitle, opts)
+	}
+	input, offsets := opts.preprocessWithOffsets(input)
+	r := newSpanRecorder(len(input))
+	buf := make([]byte, 0, len(input)+len(input)/2)
+	buf = appendWords(buf, input, &opts, WordCaseTitle, WordCaseTitle, " ", r,
+		newTitleRules(input, opts.titleMinorWords()))
+	return r.finish(buf, offsets)
 }
 
 // TitleCase converts the input string to title case.
@@ -15,8 +45,30 @@ func TitleCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func TitleCase(input string) string {
-	return Capitalize(input, ' ', Options{
+	return Format(input, styleTitle, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendTitleCase appends the input string converted to title case to dst
+// and returns the extended buffer, in the same way as TitleCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendTitleCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleTitle, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// IsTitleCaseWithOptions reports whether the input string is in title case
+// with the specified options, that is, whether TitleCaseWithOptions
+// returns it unchanged.
+func IsTitleCaseWithOptions(input string, opts Options) bool {
+	return TitleCaseWithOptions(input, opts) == input
+}
+
+// IsTitleCase reports whether the input string is in title case.
+func IsTitleCase(input string) bool {
+	return TitleCase(input) == input
+}
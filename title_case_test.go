@@ -1490,3 +1490,82 @@ func TestTitleCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestTitleCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.TitleCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "A B C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.TitleCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsTitleCase(t *testing.T) {
+	t.Run("string in title case", func(t *testing.T) {
+		assert.True(t, stringcase.IsTitleCase("User Account Settings"))
+		assert.True(t, stringcase.IsTitleCase(""))
+	})
+
+	t.Run("string not in title case", func(t *testing.T) {
+		assert.False(t, stringcase.IsTitleCase("user_account_settings"))
+		assert.False(t, stringcase.IsTitleCase("User Account Settings_"))
+		assert.False(t, stringcase.IsTitleCase("Rate% Limit"))
+	})
+}
+
+func TestIsTitleCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in title case", func(t *testing.T) {
+		assert.True(t, stringcase.IsTitleCaseWithOptions("Rate% Limit", opts))
+		assert.True(t, stringcase.IsTitleCaseWithOptions("User Account Settings", opts))
+	})
+
+	t.Run("string not in title case", func(t *testing.T) {
+		assert.False(t, stringcase.IsTitleCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsTitleCaseWithOptions("User Account Settings_", opts))
+	})
+}
+
+func TestAppendTitleCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendTitleCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=User Account Settings")
+
+		buf = stringcase.AppendTitleCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "User Account Settings")
+	})
+
+	t.Run("same result as TitleCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendTitleCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.TitleCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendTitleCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TitleStyle is the style guide which decides the words written in lowercase in title case, such
+// as articles, short conjunctions, and prepositions. It is specified in the TitleStyle field of
+// Options.
+type TitleStyle uint8
+
+const (
+	// TitleStyleNone capitalizes all words, so "the lord of the rings" becomes
+	// "The Lord Of The Rings".
+	TitleStyleNone TitleStyle = iota
+
+	// TitleStyleAP lowercases articles, and conjunctions and prepositions of three letters or
+	// fewer, following the Associated Press Stylebook, so "a guide to living without fear"
+	// becomes "A Guide to Living Without Fear".
+	TitleStyleAP
+
+	// TitleStyleChicago lowercases articles, the conjunctions "and", "but", "for", "or", and
+	// "nor", and all prepositions regardless of their length, following The Chicago Manual of
+	// Style, so "a guide to living without fear" becomes "A Guide to Living without Fear".
+	TitleStyleChicago
+
+	// TitleStyleAPA lowercases articles, and conjunctions and prepositions of three letters or
+	// fewer, following the Publication Manual of the American Psychological Association.
+	// It differs from TitleStyleAP in that the conjunction "if" is also lowercased.
+	TitleStyleAPA
+
+	// TitleStyleMLA lowercases articles, all coordinating conjunctions including "so" and "yet",
+	// and all prepositions regardless of their length, following the MLA Handbook.
+	TitleStyleMLA
+)
+
+var (
+	articles = []string{"a", "an", "the"}
+
+	shortPrepositions = []string{
+		"as", "at", "by", "for", "in", "of", "off", "on", "per", "to", "up", "via",
+	}
+
+	prepositions = []string{
+		"aboard", "about", "above", "across", "after", "against", "along", "amid", "among",
+		"around", "as", "at", "atop", "before", "behind", "below", "beneath", "beside", "besides",
+		"between", "beyond", "by", "despite", "down", "during", "except", "for", "from", "in",
+		"inside", "into", "like", "near", "of", "off", "on", "onto", "out", "outside", "over",
+		"per", "since", "than", "through", "throughout", "till", "to", "toward", "towards",
+		"under", "underneath", "unlike", "until", "up", "upon", "versus", "via", "with",
+		"within", "without",
+	}
+)
+
+// minorWords returns the lists of the words which are lowercased in the title style.
+func (ts TitleStyle) minorWords() [][]string {
+	switch ts {
+	case TitleStyleAP:
+		return [][]string{articles, {"and", "but", "nor", "or", "so", "yet"}, shortPrepositions}
+	case TitleStyleChicago:
+		return [][]string{articles, {"and", "but", "nor", "or"}, prepositions}
+	case TitleStyleAPA:
+		return [][]string{
+			articles, {"and", "but", "if", "nor", "or", "so", "yet"}, shortPrepositions,
+		}
+	case TitleStyleMLA:
+		return [][]string{articles, {"and", "but", "nor", "or", "so", "yet"}, prepositions}
+	default:
+		return nil
+	}
+}
+
+// hasTitleRules reports whether the title case conversion lowercases minor words.
+func (opts *Options) hasTitleRules() bool {
+	return opts.TitleStyle != TitleStyleNone || len(opts.MinorWords.words()) > 0
+}
+
+// titleRules is the proseRules for title case with a style guide. The words which match the minor
+// words are lowercased except at the start and end of the title and after a colon or sentence
+// terminators. When the input string is prose, which includes whitespace, a hyphen directly
+// between two words is kept as a part of a hyphenated compound, like "State-of-the-Art", and the
+// first element of a compound is always capitalized.
+type titleRules struct {
+	minorWords [][]string
+	isProse    bool
+}
+
+func newTitleRules(input string, minorWords [][]string) titleRules {
+	return titleRules{
+		minorWords: minorWords,
+		isProse:    strings.IndexFunc(input, unicode.IsSpace) >= 0,
+	}
+}
+
+// titleMinorWords returns the lists of the minor words of opts.TitleStyle and opts.MinorWords.
+func (opts *Options) titleMinorWords() [][]string {
+	return append(opts.TitleStyle.minorWords(), opts.MinorWords.words())
+}
+
+func (rules titleRules) head(
+	seps, word, rest string, isFirst, isLast bool,
+) (string, string, WordCase) {
+	if isFirst {
+		return "", "", WordCaseTitle
+	}
+	if t, ok := findSentenceTerminators(seps); ok {
+		return t, " ", WordCaseTitle
+	}
+	if i := strings.IndexByte(seps, ':'); i >= 0 &&
+		strings.IndexFunc(seps[i+1:], unicode.IsSpace) >= 0 {
+		return ":", " ", WordCaseTitle
+	}
+	if seps == "-" && rules.isProse {
+		if !isLast && rules.isMinorWord(word) {
+			return "", "-", WordCaseLower
+		}
+		return "", "-", WordCaseTitle
+	}
+	if isLast || rules.isCompoundHead(rest) || !rules.isMinorWord(word) {
+		return "", " ", WordCaseTitle
+	}
+	return "", " ", WordCaseLower
+}
+
+func (titleRules) tail(seps string) string {
+	t, _ := findSentenceTerminators(seps)
+	return t
+}
+
+// isCompoundHead reports whether a word followed by rest is the first element of a hyphenated
+// compound, which is capitalized even if it is a minor word, like "Up-to-Date".
+func (rules titleRules) isCompoundHead(rest string) bool {
+	return rules.isProse && len(rest) > 1 && rest[0] == '-' && !unicode.IsSpace(rune(rest[1]))
+}
+
+func (rules titleRules) isMinorWord(word string) bool {
+	for _, list := range rules.minorWords {
+		for _, w := range list {
+			if strings.EqualFold(word, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestTitleStyle(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true}
+
+	t.Run("TitleStyleNone", func(t *testing.T) {
+		result := stringcase.TitleCaseWithOptions("the lord of the rings", opts)
+		assert.Equal(t, result, "The Lord Of The Rings")
+
+		result = stringcase.TitleCaseWithOptions("state-of-the-art design: a study", opts)
+		assert.Equal(t, result, "State Of The Art Design A Study")
+	})
+
+	t.Run("TitleStyleAP", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleAP
+
+		result := stringcase.TitleCaseWithOptions("the lord of the rings", opts)
+		assert.Equal(t, result, "The Lord of the Rings")
+
+		result = stringcase.TitleCaseWithOptions("a guide to living without fear", opts)
+		assert.Equal(t, result, "A Guide to Living Without Fear")
+
+		result = stringcase.TitleCaseWithOptions("so what if it is", opts)
+		assert.Equal(t, result, "So What If It Is")
+
+		result = stringcase.TitleCaseWithOptions("bread and butter or jam", opts)
+		assert.Equal(t, result, "Bread and Butter or Jam")
+	})
+
+	t.Run("TitleStyleChicago", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleChicago
+
+		result := stringcase.TitleCaseWithOptions("THE LORD OF THE RINGS", opts)
+		assert.Equal(t, result, "The Lord of the Rings")
+
+		result = stringcase.TitleCaseWithOptions("a guide to living without fear", opts)
+		assert.Equal(t, result, "A Guide to Living without Fear")
+
+		result = stringcase.TitleCaseWithOptions("slow yet steady", opts)
+		assert.Equal(t, result, "Slow Yet Steady")
+	})
+
+	t.Run("TitleStyleAPA", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleAPA
+
+		result := stringcase.TitleCaseWithOptions("the lord of the rings", opts)
+		assert.Equal(t, result, "The Lord of the Rings")
+
+		result = stringcase.TitleCaseWithOptions("so what if it is", opts)
+		assert.Equal(t, result, "So What if It Is")
+
+		result = stringcase.TitleCaseWithOptions("a guide to living without fear", opts)
+		assert.Equal(t, result, "A Guide to Living Without Fear")
+	})
+
+	t.Run("TitleStyleMLA", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleMLA
+
+		result := stringcase.TitleCaseWithOptions("the lord of the rings", opts)
+		assert.Equal(t, result, "The Lord of the Rings")
+
+		result = stringcase.TitleCaseWithOptions("a guide to living without fear", opts)
+		assert.Equal(t, result, "A Guide to Living without Fear")
+
+		result = stringcase.TitleCaseWithOptions("slow yet steady", opts)
+		assert.Equal(t, result, "Slow yet Steady")
+	})
+
+	t.Run("capitalize minor words at the start and end", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleChicago
+
+		result := stringcase.TitleCaseWithOptions("of mice and men", opts)
+		assert.Equal(t, result, "Of Mice and Men")
+
+		result = stringcase.TitleCaseWithOptions("what dreams are made of", opts)
+		assert.Equal(t, result, "What Dreams Are Made Of")
+
+		result = stringcase.TitleCaseWithOptions("  of  ", opts)
+		assert.Equal(t, result, "Of")
+	})
+
+	t.Run("capitalize after colons and sentence terminators", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleChicago
+
+		result := stringcase.TitleCaseWithOptions("design patterns: a study of reuse", opts)
+		assert.Equal(t, result, "Design Patterns: A Study of Reuse")
+
+		result = stringcase.TitleCaseWithOptions("what is it? an answer for you!", opts)
+		assert.Equal(t, result, "What Is It? An Answer for You!")
+
+		result = stringcase.TitleCaseWithOptions("key:the value", opts)
+		assert.Equal(t, result, "Key the Value")
+	})
+
+	t.Run("keep hyphenated compounds", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleChicago
+
+		result := stringcase.TitleCaseWithOptions("state-of-the-art design", opts)
+		assert.Equal(t, result, "State-of-the-Art Design")
+
+		result = stringcase.TitleCaseWithOptions("keeping up-to-date", opts)
+		assert.Equal(t, result, "Keeping Up-to-Date")
+
+		result = stringcase.TitleCaseWithOptions("state - of - the - art", opts)
+		assert.Equal(t, result, "State of the Art")
+
+		result = stringcase.TitleCaseWithOptions("state-of-the-art", opts)
+		assert.Equal(t, result, "State of the Art")
+
+		result = stringcase.TitleCaseWithOptions("stateOfTheArt", opts)
+		assert.Equal(t, result, "State of the Art")
+	})
+
+	t.Run("extend minor words", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleAP
+		opts.MinorWords = stringcase.NewWordList("VS", "with")
+
+		result := stringcase.TitleCaseWithOptions("cats vs dogs with friends", opts)
+		assert.Equal(t, result, "Cats vs Dogs with Friends")
+
+		opts.TitleStyle = stringcase.TitleStyleNone
+		result = stringcase.TitleCaseWithOptions("cats vs the dogs", opts)
+		assert.Equal(t, result, "Cats vs The Dogs")
+	})
+
+	t.Run("keep acronyms and protected words", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleChicago
+		opts.Acronyms = stringcase.NewWordList("API")
+		opts.ProtectedWords = stringcase.NewWordList("iOS")
+
+		result := stringcase.TitleCaseWithOptions("the api for ios", opts)
+		assert.Equal(t, result, "The API for iOS")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		opts := opts
+		opts.TitleStyle = stringcase.TitleStyleChicago
+
+		result := stringcase.TitleCaseWithOptions("", opts)
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestTitleStyleWithMapping(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, TitleStyle: stringcase.TitleStyleChicago}
+
+	t.Run("map the lowercased minor words and the kept punctuation", func(t *testing.T) {
+		result, spans := stringcase.TitleCaseWithMapping(" war of it: the end!", opts)
+		assert.Equal(t, result, "War of It: The End!")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 3},
+			{OutStart: 2, OutEnd: 3, InStart: 3, InEnd: 4},
+			{OutStart: 3, OutEnd: 4, InStart: 4, InEnd: 5},
+			{OutStart: 4, OutEnd: 5, InStart: 5, InEnd: 6},
+			{OutStart: 5, OutEnd: 6, InStart: 6, InEnd: 7},
+			{OutStart: 6, OutEnd: 7, InStart: 7, InEnd: 8},
+			{OutStart: 7, OutEnd: 8, InStart: 8, InEnd: 9},
+			{OutStart: 8, OutEnd: 9, InStart: 9, InEnd: 10},
+			{OutStart: 9, OutEnd: 11, InStart: 10, InEnd: 12},
+			{OutStart: 11, OutEnd: 12, InStart: 12, InEnd: 13},
+			{OutStart: 12, OutEnd: 13, InStart: 13, InEnd: 14},
+			{OutStart: 13, OutEnd: 14, InStart: 14, InEnd: 15},
+			{OutStart: 14, OutEnd: 15, InStart: 15, InEnd: 16},
+			{OutStart: 15, OutEnd: 16, InStart: 16, InEnd: 17},
+			{OutStart: 16, OutEnd: 17, InStart: 17, InEnd: 18},
+			{OutStart: 17, OutEnd: 18, InStart: 18, InEnd: 19},
+			{OutStart: 18, OutEnd: 19, InStart: 19, InEnd: 20},
+		})
+	})
+
+	t.Run("map joiners inserted between words", func(t *testing.T) {
+		result, spans := stringcase.TitleCaseWithMapping("aOfB", opts)
+		assert.Equal(t, result, "A of B")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 1, InStart: 0, InEnd: 1},
+			{OutStart: 1, OutEnd: 2, InStart: 1, InEnd: 1},
+			{OutStart: 2, OutEnd: 3, InStart: 1, InEnd: 2},
+			{OutStart: 3, OutEnd: 4, InStart: 2, InEnd: 3},
+			{OutStart: 4, OutEnd: 5, InStart: 3, InEnd: 3},
+			{OutStart: 5, OutEnd: 6, InStart: 3, InEnd: 4},
+		})
+	})
+
+	t.Run("without title style", func(t *testing.T) {
+		result, spans := stringcase.TitleCaseWithMapping("a_of", stringcase.Options{})
+		assert.Equal(t, result, "A Of")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 1, InStart: 0, InEnd: 1},
+			{OutStart: 1, OutEnd: 2, InStart: 1, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+		})
+	})
+}
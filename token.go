@@ -0,0 +1,135 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// TokenKind is the kind of a token which Scanner reports.
+type TokenKind uint8
+
+const (
+	// TokenWord is a sequence of letters, or a word in Options.ProtectedWords.
+	TokenWord TokenKind = iota
+
+	// TokenDigits is a sequence of decimal digits.
+	TokenDigits
+
+	// TokenKeptMark is a sequence of the non-alphanumeric characters kept in the results of the
+	// conversions.
+	TokenKeptMark
+
+	// TokenSeparator is a sequence of the characters removed from the results of the conversions
+	// as word separators.
+	TokenSeparator
+)
+
+// Token is a piece of an input string which Scanner reports.
+//
+// Start and End are the byte offsets of the token in the input string, and WordStart reports
+// whether a word boundary is placed just before the token. The tokens from a WordStart token up to
+// the next separator or WordStart token form a word, which is the same as one returned by Words.
+type Token struct {
+	Kind      TokenKind
+	Start     int
+	End       int
+	WordStart bool
+}
+
+// Scanner splits an input string into tokens with the same rules as the conversion functions.
+//
+// Each token is a sequence of the characters of the same kind in a word, or a sequence of
+// separators, and the tokens cover the whole input string without gaps. If Options.Normalization
+// or Options.FoldToASCII is specified, the input string is split after normalization and folding,
+// but the offsets of the tokens are those in the original input string. When a token starts or
+// ends inside the characters converted from other characters, such as "AE" folded from "Æ", its
+// range is extended to cover the whole original characters. A token which then overlaps the
+// previous token starts at its end, or is merged into it if the previous token covers the whole
+// token, and the previous token takes the kind and the word boundary of the merged token if it is
+// a separator.
+type Scanner struct {
+	input  string
+	tokens []Token
+	index  int
+	token  Token
+}
+
+// NewScanner creates a Scanner which reads tokens from the input string with the options.
+func NewScanner(input string, opts Options) *Scanner {
+	preprocessed, offsets := opts.preprocessWithOffsets(input)
+	tokens := appendTokens(make([]Token, 0, 8), preprocessed, &opts)
+	merged := tokens[:0]
+	for _, token := range tokens {
+		token.Start, token.End = offsets.start(token.Start), offsets.end(token.End)
+		if k := len(merged); k > 0 && token.Start < merged[k-1].End {
+			last := &merged[k-1]
+			if token.End > last.End {
+				token.Start = last.End
+			} else {
+				if last.Kind == TokenSeparator {
+					last.Kind, last.WordStart = token.Kind, token.WordStart
+				}
+				continue
+			}
+		}
+		merged = append(merged, token)
+	}
+	return &Scanner{input: input, tokens: merged}
+}
+
+// Scan advances the Scanner to the next token, which is then available through the Token method.
+// It returns false when there are no more tokens.
+func (s *Scanner) Scan() bool {
+	if s.index >= len(s.tokens) {
+		return false
+	}
+	s.token = s.tokens[s.index]
+	s.index++
+	return true
+}
+
+// Token returns the token found by the most recent call to Scan.
+func (s *Scanner) Token() Token {
+	return s.token
+}
+
+// Text returns the part of the input string covered by the token found by the most recent call
+// to Scan.
+func (s *Scanner) Text() string {
+	return s.input[s.token.Start:s.token.End]
+}
+
+// Tokenize splits the input string into tokens with the options, and returns all of them. See
+// Scanner for the details of the tokens.
+func Tokenize(input string, opts Options) []Token {
+	return NewScanner(input, opts).tokens
+}
+
+// appendTokens splits the input string, which is already preprocessed, into tokens at the same
+// word boundaries as the conversion functions find, and appends them to tokens.
+func appendTokens(tokens []Token, input string, opts *Options) []Token {
+	s := newWordScanner(input, opts)
+	for s.scan() {
+		kind := TokenWord
+		switch {
+		case len(s.protected) > 0:
+		case s.class == charIsDigit:
+			kind = TokenDigits
+		case s.class == charIsKeptMark:
+			kind = TokenKeptMark
+		case s.class == charIsSepMark:
+			kind = TokenSeparator
+		}
+		tokens = appendToken(tokens, kind, s.start, s.end, s.isHead || s.newWord)
+	}
+	return tokens
+}
+
+// appendToken appends a unit of the kind to tokens, by extending the last token if the unit
+// continues it.
+func appendToken(tokens []Token, kind TokenKind, start, end int, wordStart bool) []Token {
+	if n := len(tokens); n > 0 && !wordStart && tokens[n-1].Kind == kind {
+		tokens[n-1].End = end
+		return tokens
+	}
+	return append(tokens, Token{Kind: kind, Start: start, End: end, WordStart: wordStart})
+}
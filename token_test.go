@@ -0,0 +1,169 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestTokenize(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("split into tokens of each kind", func(t *testing.T) {
+		opts := origOpts
+		opts.Keep = "#"
+
+		result := stringcase.Tokenize("_fooBar100#baz--Qux", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenSeparator, Start: 0, End: 1, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 1, End: 4, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 4, End: 7, WordStart: true},
+			{Kind: stringcase.TokenDigits, Start: 7, End: 10, WordStart: false},
+			{Kind: stringcase.TokenKeptMark, Start: 10, End: 11, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 11, End: 14, WordStart: true},
+			{Kind: stringcase.TokenSeparator, Start: 14, End: 16, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 16, End: 19, WordStart: true},
+		})
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result := stringcase.Tokenize("", origOpts)
+		assert.Equal(t, result, []stringcase.Token{})
+	})
+
+	t.Run("split before the last uppercase letter of an acronym", func(t *testing.T) {
+		opts := origOpts
+		opts.SeparateBeforeNonAlphabets = true
+
+		result := stringcase.Tokenize("HTTPServer2", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 4, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 4, End: 10, WordStart: true},
+			{Kind: stringcase.TokenDigits, Start: 10, End: 11, WordStart: true},
+		})
+	})
+
+	t.Run("protected words", func(t *testing.T) {
+		opts := origOpts
+		opts.ProtectedWords = stringcase.NewWordList("OAuth2", "C++")
+
+		result := stringcase.Tokenize("oauth2Token c++", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 6, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 6, End: 11, WordStart: true},
+			{Kind: stringcase.TokenSeparator, Start: 11, End: 12, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 12, End: 15, WordStart: true},
+		})
+
+		opts.ProtectedWords = stringcase.NewWordList("GraphQL")
+		result = stringcase.Tokenize("HTTPGraphQLClient", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 4, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 4, End: 11, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 11, End: 17, WordStart: true},
+		})
+	})
+
+	t.Run("offsets in the original input string", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.Normalization = stringcase.NormalizationNFC
+
+		input := "Cre\u0300me-Bru\u0302le\u0301e"
+		result := stringcase.Tokenize(input, opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 7, WordStart: true},
+			{Kind: stringcase.TokenSeparator, Start: 7, End: 8, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 8, End: 18, WordStart: true},
+		})
+
+		opts.Normalization = stringcase.NormalizationNone
+		opts.FoldToASCII = true
+		input = "xÆbleÆ1"
+		result = stringcase.Tokenize(input, opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 1, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 1, End: 6, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 6, End: 8, WordStart: true},
+			{Kind: stringcase.TokenDigits, Start: 8, End: 9, WordStart: false},
+		})
+
+		opts.Normalization = stringcase.NormalizationNFC
+		opts.FoldToASCII = false
+		input = "\u03ac\u03bb\u03c6\u03b1\u0392\u03ae\u03c4\u03b1-\u03b3\u03ac\u03bc\u03bc\u03b1"
+		result = stringcase.Tokenize(input, opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 8, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 8, End: 16, WordStart: true},
+			{Kind: stringcase.TokenSeparator, Start: 16, End: 17, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 17, End: 27, WordStart: true},
+		})
+
+		input = "\u03b1\u0301\u03bb\u0392\u03b7\u0301-\u03b3"
+		result = stringcase.Tokenize(input, opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 6, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 6, End: 12, WordStart: true},
+			{Kind: stringcase.TokenSeparator, Start: 12, End: 13, WordStart: false},
+			{Kind: stringcase.TokenWord, Start: 13, End: 15, WordStart: true},
+		})
+	})
+
+	t.Run("merge tokens overlapping in the original input string", func(t *testing.T) {
+		opts := origOpts
+		opts.Normalization = stringcase.NormalizationNFD
+
+		result := stringcase.Tokenize("\u00e9Bar", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 2, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 2, End: 5, WordStart: true},
+		})
+
+		opts.Normalization = stringcase.NormalizationNone
+		opts.FoldToASCII = true
+		opts.SeparateScripts = true
+		result = stringcase.Tokenize("\u00c6\u03b2", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 4, WordStart: true},
+		})
+
+		opts = origOpts
+		opts.Normalization = stringcase.NormalizationNFKC
+		result = stringcase.Tokenize("x_\u0149", opts)
+		assert.Equal(t, result, []stringcase.Token{
+			{Kind: stringcase.TokenWord, Start: 0, End: 1, WordStart: true},
+			{Kind: stringcase.TokenWord, Start: 1, End: 4, WordStart: true},
+		})
+
+		opts.Normalization = stringcase.NormalizationNFD
+		s := stringcase.NewScanner("caf\u00e9-\u00e9t\u00e9", opts)
+		texts := []string{}
+		for s.Scan() {
+			texts = append(texts, s.Text())
+		}
+		assert.Equal(t, texts, []string{"caf\u00e9", "-", "\u00e9", "t\u00e9"})
+	})
+
+	t.Run("scanner", func(t *testing.T) {
+		opts := origOpts
+		opts.FoldToASCII = true
+
+		s := stringcase.NewScanner("Straße-100", opts)
+		texts := []string{}
+		kinds := []stringcase.TokenKind{}
+		for s.Scan() {
+			texts = append(texts, s.Text())
+			kinds = append(kinds, s.Token().Kind)
+		}
+		assert.Equal(t, texts, []string{"Straße", "-", "100"})
+		assert.Equal(t, kinds, []stringcase.TokenKind{
+			stringcase.TokenWord, stringcase.TokenSeparator, stringcase.TokenDigits,
+		})
+		assert.False(t, s.Scan())
+	})
+}
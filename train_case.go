@@ -7,7 +7,14 @@ package stringcase
 // TrainCaseWithOptions converts the input string to train case with the
 // specified options.
 func TrainCaseWithOptions(input string, opts Options) string {
-	return Capitalize(input, '-', opts)
+	return Format(input, styleTrain, opts)
+}
+
+// TrainCaseWithMapping converts the input string to train case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func TrainCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleTrain, opts)
 }
 
 // TrainCase converts the input string to train case.
@@ -15,8 +22,30 @@ func TrainCaseWithOptions(input string, opts Options) string {
 // It treats the end of a sequence of non-alphabetical characters as a
 // word boundary, but not the beginning.
 func TrainCase(input string) string {
-	return Capitalize(input, '-', Options{
+	return Format(input, styleTrain, Options{
 		SeparateBeforeNonAlphabets: false,
 		SeparateAfterNonAlphabets:  true,
 	})
 }
+
+// AppendTrainCase appends the input string converted to train case to dst
+// and returns the extended buffer, in the same way as TrainCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendTrainCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleTrain, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsTrainCaseWithOptions reports whether the input string is in train case
+// with the specified options, that is, whether TrainCaseWithOptions
+// returns it unchanged.
+func IsTrainCaseWithOptions(input string, opts Options) bool {
+	return TrainCaseWithOptions(input, opts) == input
+}
+
+// IsTrainCase reports whether the input string is in train case.
+func IsTrainCase(input string) bool {
+	return TrainCase(input) == input
+}
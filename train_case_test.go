@@ -1286,3 +1286,82 @@ func TestTrainCaseWithOptions(t *testing.T) {
 		})
 	})
 }
+
+func TestTrainCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.TrainCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "A-B-C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.TrainCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsTrainCase(t *testing.T) {
+	t.Run("string in train case", func(t *testing.T) {
+		assert.True(t, stringcase.IsTrainCase("User-Account-Settings"))
+		assert.True(t, stringcase.IsTrainCase(""))
+	})
+
+	t.Run("string not in train case", func(t *testing.T) {
+		assert.False(t, stringcase.IsTrainCase("user_account_settings"))
+		assert.False(t, stringcase.IsTrainCase("User-Account-Settings_"))
+		assert.False(t, stringcase.IsTrainCase("Rate%-Limit"))
+	})
+}
+
+func TestIsTrainCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in train case", func(t *testing.T) {
+		assert.True(t, stringcase.IsTrainCaseWithOptions("Rate%-Limit", opts))
+		assert.True(t, stringcase.IsTrainCaseWithOptions("User-Account-Settings", opts))
+	})
+
+	t.Run("string not in train case", func(t *testing.T) {
+		assert.False(t, stringcase.IsTrainCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsTrainCaseWithOptions("User-Account-Settings_", opts))
+	})
+}
+
+func TestAppendTrainCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendTrainCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=User-Account-Settings")
+
+		buf = stringcase.AppendTrainCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "User-Account-Settings")
+	})
+
+	t.Run("same result as TrainCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendTrainCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.TrainCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendTrainCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// isUpperCase reports whether the rune is an uppercase or titlecase letter. Non-ASCII letters are
+// classified only when isUnicode is true, which is given from Options.Unicode.
+func isUpperCase(r rune, isUnicode bool) bool {
+	if r < utf8.RuneSelf || !isUnicode {
+		return isAsciiUpperCase(r)
+	}
+	return isUnicodeUpperCase(r)
+}
+
+// isLowerCase reports whether the rune is a lowercase letter. Non-ASCII letters are classified
+// only when isUnicode is true.
+func isLowerCase(r rune, isUnicode bool) bool {
+	if r < utf8.RuneSelf || !isUnicode {
+		return isAsciiLowerCase(r)
+	}
+	return isUnicodeLowerCase(r)
+}
+
+// isDigit reports whether the rune is a decimal digit. Non-ASCII digits are classified only when
+// isUnicode is true.
+func isDigit(r rune, isUnicode bool) bool {
+	if r < utf8.RuneSelf || !isUnicode {
+		return isAsciiDigit(r)
+	}
+	return isUnicodeDigit(r)
+}
+
+func isUnicodeUpperCase(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+func isUnicodeLowerCase(r rune) bool {
+	return unicode.IsLower(r)
+}
+
+func isUnicodeDigit(r rune) bool {
+	return unicode.IsDigit(r)
+}
+
+func toUpperCase(r rune) rune {
+	if r < utf8.RuneSelf {
+		if isAsciiLowerCase(r) {
+			return toAsciiUpperCase(r)
+		}
+		return r
+	}
+	return unicode.ToUpper(r)
+}
+
+func toLowerCase(r rune) rune {
+	if isAsciiUpperCase(r) {
+		return toAsciiLowerCase(r)
+	}
+	return unicode.ToLower(r)
+}
+
+func toTitleCase(r rune) rune {
+	return unicode.ToTitle(r)
+}
+
+// caseMapping is the case mapping applied to a letter.
+type caseMapping uint8
+
+const (
+	mapToLower caseMapping = iota
+	mapToUpper
+	mapToTitle
+)
+
+// appendCasedLetter appends the letter and the combining marks following it in the same grapheme
+// cluster to result with the case mapping. Non-ASCII letters reach here only when Options.Unicode
+// is true, so they are always mapped with Unicode case mappings, including the mappings to more
+// than one character, and the rules of the locale are applied before them. The combining marks
+// are also used for the context of the locale-specific rules.
+func appendCasedLetter(result []byte, ch rune, marks string, m caseMapping, locale Locale) []byte {
+	result = appendCasedRune(result, ch, marks, m, locale)
+	if len(marks) > 0 {
+		result = appendCasedMarks(result, ch, marks, m, locale)
+	}
+	return result
+}
+
+func appendCasedRune(result []byte, ch rune, marks string, m caseMapping, locale Locale) []byte {
+	if locale != LocaleNone {
+		if r, ok := appendLocaleCase(result, ch, m, marks, locale); ok {
+			return r
+		}
+	}
+	if ch < utf8.RuneSelf {
+		switch m {
+		case mapToLower:
+			return append(result, byte(toLowerCase(ch)))
+		default:
+			return append(result, byte(toUpperCase(ch)))
+		}
+	}
+	if sc := findSpecialCasing(ch); sc != nil {
+		switch m {
+		case mapToUpper:
+			return append(result, sc.upper...)
+		case mapToTitle:
+			return append(result, sc.title...)
+		default:
+			return append(result, sc.lower...)
+		}
+	}
+	switch m {
+	case mapToUpper:
+		return utf8.AppendRune(result, toUpperCase(ch))
+	case mapToTitle:
+		return utf8.AppendRune(result, toTitleCase(ch))
+	default:
+		return utf8.AppendRune(result, toLowerCase(ch))
+	}
+}
+
+// appendCasedMarks appends the runes following a letter in a grapheme cluster to result. They are
+// case-mapped like the letter, except that they are lowercased when the letter is titlecased.
+func appendCasedMarks(result []byte, ch rune, marks string, m caseMapping, locale Locale) []byte {
+	isDotRemoved := locale != LocaleNone && isDotAboveRemoved(ch, m, marks, locale)
+	if m == mapToTitle {
+		m = mapToLower
+	}
+	for _, r := range marks {
+		if isDotRemoved && r == combiningDotAbove {
+			isDotRemoved = false
+			continue
+		}
+		switch m {
+		case mapToUpper:
+			result = utf8.AppendRune(result, toUpperCase(r))
+		default:
+			result = utf8.AppendRune(result, toLowerCase(r))
+		}
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package stringcase_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestUnicodeOption(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+		Unicode:                    true,
+	}
+
+	t.Run("convert Latin-1 letters", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("naïveBayes", opts)
+		assert.Equal(t, result, "naïve_bayes")
+
+		result = stringcase.SnakeCaseWithOptions("ÜberWagen", opts)
+		assert.Equal(t, result, "über_wagen")
+
+		result = stringcase.CamelCaseWithOptions("ÜberWagen", opts)
+		assert.Equal(t, result, "überWagen")
+
+		result = stringcase.PascalCaseWithOptions("über_wagen", opts)
+		assert.Equal(t, result, "ÜberWagen")
+
+		result = stringcase.MacroCaseWithOptions("naïveBayes", opts)
+		assert.Equal(t, result, "NAÏVE_BAYES")
+	})
+
+	t.Run("convert Latin Extended letters", func(t *testing.T) {
+		result := stringcase.KebabCaseWithOptions("ŁódźŚląsk", opts)
+		assert.Equal(t, result, "łódź-śląsk")
+
+		result = stringcase.TrainCaseWithOptions("łódź_śląsk", opts)
+		assert.Equal(t, result, "Łódź-Śląsk")
+	})
+
+	t.Run("convert Cyrillic letters", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("ПриветМир", opts)
+		assert.Equal(t, result, "привет_мир")
+
+		result = stringcase.CobolCaseWithOptions("приветМир", opts)
+		assert.Equal(t, result, "ПРИВЕТ-МИР")
+
+		result = stringcase.AdaCaseWithOptions("привет мир", opts)
+		assert.Equal(t, result, "Привет_Мир")
+	})
+
+	t.Run("convert Greek letters", func(t *testing.T) {
+		result := stringcase.KebabCaseWithOptions("ΚαλημέραΚόσμε", opts)
+		assert.Equal(t, result, "καλημέρα-κόσμε")
+
+		result = stringcase.TitleCaseWithOptions("καλημέρα_κόσμε", opts)
+		assert.Equal(t, result, "Καλημέρα Κόσμε")
+	})
+
+	t.Run("convert a sequence of uppercase letters", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("ÀÉÎõü", opts)
+		assert.Equal(t, result, "àé_îõü")
+
+		result = stringcase.PascalCaseWithOptions("ÀÉÎõü", opts)
+		assert.Equal(t, result, "ÀéÎõü")
+	})
+
+	t.Run("convert titlecase letters", func(t *testing.T) {
+		result := stringcase.PascalCaseWithOptions("ǆungla_ǄUNGLA", opts)
+		assert.Equal(t, result, "ǅunglaǅungla")
+
+		result = stringcase.SnakeCaseWithOptions("ǅungla", opts)
+		assert.Equal(t, result, "ǆungla")
+	})
+
+	t.Run("keep non-ASCII decimal digits", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("abc٣def", opts)
+		assert.Equal(t, result, "abc٣_def")
+
+		result = stringcase.CamelCaseWithOptions("abc_３def", opts)
+		assert.Equal(t, result, "abc３Def")
+	})
+
+	t.Run("treat letters without case as non-alphanumeric", func(t *testing.T) {
+		result := stringcase.SnakeCaseWithOptions("fooキーBar", opts)
+		assert.Equal(t, result, "foo_bar")
+
+		o := opts
+		o.Keep = "キー"
+		result = stringcase.SnakeCaseWithOptions("fooキーBar", o)
+		assert.Equal(t, result, "fooキー_bar")
+	})
+
+	t.Run("convert with custom joiners", func(t *testing.T) {
+		result := stringcase.Capitalize("naïveBayes", '.', opts)
+		assert.Equal(t, result, "Naïve.Bayes")
+
+		result = stringcase.Lowerize("ÜberWagen", '.', opts)
+		assert.Equal(t, result, "über.wagen")
+
+		result = stringcase.Upperize("ÜberWagen", '.', opts)
+		assert.Equal(t, result, "ÜBER.WAGEN")
+	})
+
+	t.Run("ASCII only by default", func(t *testing.T) {
+		result := stringcase.SnakeCase("naïveBayes")
+		assert.Equal(t, result, "na_ve_bayes")
+
+		result = stringcase.SnakeCase("ÜberWagen")
+		assert.Equal(t, result, "ber_wagen")
+	})
+
+	t.Run("same as ASCII only mode for ASCII strings", func(t *testing.T) {
+		const chars = "aBcXyZ019_-.%# "
+		rnd := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 2000; i++ {
+			b := make([]byte, rnd.Intn(16))
+			for j := range b {
+				b[j] = chars[rnd.Intn(len(chars))]
+			}
+			input := string(b)
+
+			ascii := stringcase.Options{
+				SeparateBeforeNonAlphabets: rnd.Intn(2) == 0,
+				SeparateAfterNonAlphabets:  rnd.Intn(2) == 0,
+			}
+			switch rnd.Intn(3) {
+			case 1:
+				ascii.Separators = "_-"
+			case 2:
+				ascii.Keep = ".%"
+			}
+			uni := ascii
+			uni.Unicode = true
+
+			assert.Equal(t, stringcase.Capitalize(input, '+', uni), stringcase.Capitalize(input, '+', ascii), input)
+			assert.Equal(t, stringcase.Lowerize(input, '+', uni), stringcase.Lowerize(input, '+', ascii), input)
+			assert.Equal(t, stringcase.Upperize(input, '+', uni), stringcase.Upperize(input, '+', ascii), input)
+			assert.Equal(t, stringcase.CamelCaseWithOptions(input, uni), stringcase.CamelCaseWithOptions(input, ascii), input)
+			assert.Equal(t, stringcase.PascalCaseWithOptions(input, uni), stringcase.PascalCaseWithOptions(input, ascii), input)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// UpperFlatCaseWithOptions converts the input string to upper flat case with the
+// specified options.
+func UpperFlatCaseWithOptions(input string, opts Options) string {
+	return Format(input, styleUpperFlat, opts)
+}
+
+// UpperFlatCaseWithMapping converts the input string to upper flat case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func UpperFlatCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleUpperFlat, opts)
+}
+
+// UpperFlatCase converts the input string to upper flat case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func UpperFlatCase(input string) string {
+	return Format(input, styleUpperFlat, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendUpperFlatCase appends the input string converted to upper flat case to dst
+// and returns the extended buffer, in the same way as UpperFlatCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendUpperFlatCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleUpperFlat, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsUpperFlatCaseWithOptions reports whether the input string is in upper flat case
+// with the specified options, that is, whether UpperFlatCaseWithOptions
+// returns it unchanged.
+func IsUpperFlatCaseWithOptions(input string, opts Options) bool {
+	return UpperFlatCaseWithOptions(input, opts) == input
+}
+
+// IsUpperFlatCase reports whether the input string is in upper flat case.
+func IsUpperFlatCase(input string) bool {
+	return UpperFlatCase(input) == input
+}
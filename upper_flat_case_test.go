@@ -0,0 +1,1343 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestUpperFlatCase(t *testing.T) {
+	t.Run("convert camelCase", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("abcDefGHIjk")
+		assert.Equal(t, result, "ABCDEFGHIJK")
+	})
+
+	t.Run("convert PascalCase", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("AbcDefGHIjk")
+		assert.Equal(t, result, "ABCDEFGHIJK")
+	})
+
+	t.Run("convert snake_case", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("abc_def_ghi")
+		assert.Equal(t, result, "ABCDEFGHI")
+	})
+
+	t.Run("convert kebab-case", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("abc-def-ghi")
+		assert.Equal(t, result, "ABCDEFGHI")
+	})
+
+	t.Run("convert Train-Case", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("Abc-Def-Ghi")
+		assert.Equal(t, result, "ABCDEFGHI")
+	})
+
+	t.Run("convert MACRO_CASE", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("ABC_DEF_GHI")
+		assert.Equal(t, result, "ABCDEFGHI")
+	})
+
+	t.Run("convert COBOL-CASE", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("ABC-DEF-GHI")
+		assert.Equal(t, result, "ABCDEFGHI")
+	})
+
+	t.Run("convert with keeping digits", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("abc123-456defG89HIJklMN12")
+		assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+	})
+
+	t.Run("convert with symbols as seperators", func(t *testing.T) {
+		result := stringcase.UpperFlatCase(":.abc~!@def#$ghi%&jk(lm)no/?")
+		assert.Equal(t, result, "ABCDEFGHIJKLMNO")
+	})
+
+	t.Run("convert when starting with digit", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("123abc456def")
+		assert.Equal(t, result, "123ABC456DEF")
+
+		result = stringcase.UpperFlatCase("123ABC456DEF")
+		assert.Equal(t, result, "123ABC456DEF")
+
+		result = stringcase.UpperFlatCase("123Abc456Def")
+		assert.Equal(t, result, "123ABC456DEF")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.UpperFlatCase("")
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestUpperFlatCaseWithOptions(t *testing.T) {
+	t.Run("non-alphabets as head of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABCDEFGHIJKLMNO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABCDEFGHIJKLMNO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABCDEFGHIJKLMNO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABCDEFGHIJKLMNO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperFlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC123DEF")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperFlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC123DEF")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperFlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC123DEF")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperFlatCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC123DEF")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABCDEFGHIJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperFlatCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABCDEFGHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123456DEFG89HIJKLMN12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperFlatCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEFG89HIJKLMN12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperFlatCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperFlatCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperFlatCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~!DEF#GHI%JKLMNO?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperFlatCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+}
+
+func TestUpperFlatCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.UpperFlatCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "ABC1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 1, InStart: 2, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 3},
+			{OutStart: 2, OutEnd: 2, InStart: 3, InEnd: 4},
+			{OutStart: 2, OutEnd: 3, InStart: 4, InEnd: 5},
+			{OutStart: 3, OutEnd: 4, InStart: 5, InEnd: 6},
+			{OutStart: 4, OutEnd: 4, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.UpperFlatCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsUpperFlatCase(t *testing.T) {
+	t.Run("string in upper flat case", func(t *testing.T) {
+		assert.True(t, stringcase.IsUpperFlatCase("USERACCOUNTSETTINGS"))
+		assert.True(t, stringcase.IsUpperFlatCase(""))
+	})
+
+	t.Run("string not in upper flat case", func(t *testing.T) {
+		assert.False(t, stringcase.IsUpperFlatCase("user_account_settings"))
+		assert.False(t, stringcase.IsUpperFlatCase("USERACCOUNTSETTINGS_"))
+		assert.False(t, stringcase.IsUpperFlatCase("RATE%LIMIT"))
+	})
+}
+
+func TestIsUpperFlatCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in upper flat case", func(t *testing.T) {
+		assert.True(t, stringcase.IsUpperFlatCaseWithOptions("RATE%LIMIT", opts))
+		assert.True(t, stringcase.IsUpperFlatCaseWithOptions("USERACCOUNTSETTINGS", opts))
+	})
+
+	t.Run("string not in upper flat case", func(t *testing.T) {
+		assert.False(t, stringcase.IsUpperFlatCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsUpperFlatCaseWithOptions("USERACCOUNTSETTINGS_", opts))
+	})
+}
+
+func TestAppendUpperFlatCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendUpperFlatCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=USERACCOUNTSETTINGS")
+
+		buf = stringcase.AppendUpperFlatCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "USERACCOUNTSETTINGS")
+	})
+
+	t.Run("same result as UpperFlatCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendUpperFlatCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.UpperFlatCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendUpperFlatCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// UpperSpaceCaseWithOptions converts the input string to upper space case with the
+// specified options.
+func UpperSpaceCaseWithOptions(input string, opts Options) string {
+	return Format(input, styleUpperSpace, opts)
+}
+
+// UpperSpaceCaseWithMapping converts the input string to upper space case with the
+// specified options, and also returns the spans which map the result to
+// the input string.
+func UpperSpaceCaseWithMapping(input string, opts Options) (string, []Span) {
+	return FormatWithMapping(input, styleUpperSpace, opts)
+}
+
+// UpperSpaceCase converts the input string to upper space case.
+//
+// It treats the end of a sequence of non-alphabetical characters as a
+// word boundary, but not the beginning.
+func UpperSpaceCase(input string) string {
+	return Format(input, styleUpperSpace, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// AppendUpperSpaceCase appends the input string converted to upper space case to dst
+// and returns the extended buffer, in the same way as UpperSpaceCase. It does
+// not allocate memory when dst has enough capacity.
+func AppendUpperSpaceCase(dst []byte, input string) []byte {
+	return AppendFormat(dst, input, styleUpperSpace, Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	})
+}
+
+// IsUpperSpaceCaseWithOptions reports whether the input string is in upper space case
+// with the specified options, that is, whether UpperSpaceCaseWithOptions
+// returns it unchanged.
+func IsUpperSpaceCaseWithOptions(input string, opts Options) bool {
+	return UpperSpaceCaseWithOptions(input, opts) == input
+}
+
+// IsUpperSpaceCase reports whether the input string is in upper space case.
+func IsUpperSpaceCase(input string) bool {
+	return UpperSpaceCase(input) == input
+}
@@ -0,0 +1,1343 @@
+package stringcase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestUpperSpaceCase(t *testing.T) {
+	t.Run("convert camelCase", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("abcDefGHIjk")
+		assert.Equal(t, result, "ABC DEF GH IJK")
+	})
+
+	t.Run("convert PascalCase", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("AbcDefGHIjk")
+		assert.Equal(t, result, "ABC DEF GH IJK")
+	})
+
+	t.Run("convert snake_case", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("abc_def_ghi")
+		assert.Equal(t, result, "ABC DEF GHI")
+	})
+
+	t.Run("convert kebab-case", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("abc-def-ghi")
+		assert.Equal(t, result, "ABC DEF GHI")
+	})
+
+	t.Run("convert Train-Case", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("Abc-Def-Ghi")
+		assert.Equal(t, result, "ABC DEF GHI")
+	})
+
+	t.Run("convert MACRO_CASE", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("ABC_DEF_GHI")
+		assert.Equal(t, result, "ABC DEF GHI")
+	})
+
+	t.Run("convert COBOL-CASE", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("ABC-DEF-GHI")
+		assert.Equal(t, result, "ABC DEF GHI")
+	})
+
+	t.Run("convert with keeping digits", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("abc123-456defG89HIJklMN12")
+		assert.Equal(t, result, "ABC123 456 DEF G89 HI JKL MN12")
+	})
+
+	t.Run("convert with symbols as seperators", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase(":.abc~!@def#$ghi%&jk(lm)no/?")
+		assert.Equal(t, result, "ABC DEF GHI JK LM NO")
+	})
+
+	t.Run("convert when starting with digit", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("123abc456def")
+		assert.Equal(t, result, "123 ABC456 DEF")
+
+		result = stringcase.UpperSpaceCase("123ABC456DEF")
+		assert.Equal(t, result, "123 ABC456 DEF")
+
+		result = stringcase.UpperSpaceCase("123Abc456Def")
+		assert.Equal(t, result, "123 ABC456 DEF")
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result := stringcase.UpperSpaceCase("")
+		assert.Equal(t, result, "")
+	})
+}
+
+func TestUpperSpaceCaseWithOptions(t *testing.T) {
+	t.Run("non-alphabets as head of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123 456DEF G 89HI JKL MN 12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABC DEF GHI JK LM NO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC 456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC 456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123 456 DEF G89 HI JKL MN12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABC DEF GHI JK LM NO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123 456 DEF G 89 HI JKL MN 12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABC DEF GHI JK LM NO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word", func(t *testing.T) {
+		opts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123 456DEF G89HI JKL MN12")
+		})
+
+		t.Run("convert with symbols as seperators", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, "ABC DEF GHI JK LM NO")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123Abc456Def", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC _DEF _GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC -DEF -GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC _DEF _GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC -DEF -GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123 456DEF G 89HI JKL MN 12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123-456DEF G 89HI JKL MN 12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC ~! DEF # GHI % JK LM NO ?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC 456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC 456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC 123DEF")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_ DEF_ GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_ DEF_ GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123 456 DEF G89 HI JKL MN12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456 DEF G89 HI JKL MN12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ". ABC~! DEF# GHI% JK LM NO ?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC123 DEF")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC _ DEF _ GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC _ DEF _ GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123 456 DEF G 89 HI JKL MN 12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123-456 DEF G 89 HI JKL MN 12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ". ABC ~! DEF # GHI % JK LM NO ?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC 123 DEF")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with separators", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123 456DEF G89HI JKL MN12")
+
+			opts.Separators = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEF G89HI JKL MN12")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = ":@$&()/"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~! DEF# GHI% JK LM NO ?")
+		})
+
+		t.Run("convert with starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+
+		t.Run("alphabets and numbers in separators are no effect", func(t *testing.T) {
+			opts := origOpts
+			opts.Separators = "-b2"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123def", opts)
+			assert.Equal(t, result, "ABC123DEF")
+		})
+	})
+
+	t.Run("non-alphabets as head of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC _DEF _GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC -DEF -GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC _DEF _GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC -DEF -GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123 456DEF G 89HI JKL MN 12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123-456DEF G 89HI JKL MN 12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC 456DEF")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC 456DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC ~! DEF # GHI % JK LM NO ?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as tail of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_ DEF_ GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_ DEF_ GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123 456 DEF G89 HI JKL MN12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456 DEF G89 HI JKL MN12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123 ABC456 DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ". ABC~! DEF# GHI% JK LM NO ?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: true,
+			SeparateAfterNonAlphabets:  true,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC _ DEF _ GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC _ DEF _ GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC - DEF - GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123 456 DEF G 89 HI JKL MN 12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC 123-456 DEF G 89 HI JKL MN 12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123 ABC 456 DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ". ABC ~! DEF # GHI % JK LM NO ?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+
+	t.Run("non-alphabets as part of a word and with kept characters", func(t *testing.T) {
+		origOpts := stringcase.Options{
+			SeparateBeforeNonAlphabets: false,
+			SeparateAfterNonAlphabets:  false,
+		}
+
+		t.Run("convert camelCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("abcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert PascalCase", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("AbcDefGHIjk", opts)
+			assert.Equal(t, result, "ABC DEF GH IJK")
+		})
+
+		t.Run("convert snake_case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("abc_def_ghi", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert kebab-case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc-def-ghi", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert Train-Case", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("Abc-Def-Ghi", opts)
+			assert.Equal(t, result, "ABC- DEF- GHI")
+		})
+
+		t.Run("convert MACRO_CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "_"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC_DEF_GHI", opts)
+			assert.Equal(t, result, "ABC_DEF_GHI")
+		})
+
+		t.Run("convert COBOL-CASE", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC DEF GHI")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("ABC-DEF-GHI", opts)
+			assert.Equal(t, result, "ABC-DEF-GHI")
+		})
+
+		t.Run("convert with keeping digits", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "_"
+			result := stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123 456DEF G89HI JKL MN12")
+
+			opts.Keep = "-"
+			result = stringcase.UpperSpaceCaseWithOptions("abc123-456defG89HIJklMN12", opts)
+			assert.Equal(t, result, "ABC123-456DEF G89HI JKL MN12")
+		})
+
+		t.Run("convert when starting with digit", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-"
+			result := stringcase.UpperSpaceCaseWithOptions("123abc456def", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+
+			result = stringcase.UpperSpaceCaseWithOptions("123ABC456DEF", opts)
+			assert.Equal(t, result, "123ABC456DEF")
+		})
+
+		t.Run("convert with symbols as separators", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = ".~!#%?"
+			result := stringcase.UpperSpaceCaseWithOptions(":.abc~!@def#$ghi%&jk(lm)no/?", opts)
+			assert.Equal(t, result, ".ABC~! DEF# GHI% JK LM NO ?")
+		})
+
+		t.Run("convert an empty string", func(t *testing.T) {
+			opts := origOpts
+			opts.Keep = "-_"
+			result := stringcase.UpperSpaceCaseWithOptions("", opts)
+			assert.Equal(t, result, "")
+		})
+	})
+}
+
+func TestUpperSpaceCaseWithMapping(t *testing.T) {
+	opts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("map the result to the input string", func(t *testing.T) {
+		result, spans := stringcase.UpperSpaceCaseWithMapping("-aB_c1-", opts)
+		assert.Equal(t, result, "A B C1")
+		assert.Equal(t, spans, []stringcase.Span{
+			{OutStart: 0, OutEnd: 0, InStart: 0, InEnd: 1},
+			{OutStart: 0, OutEnd: 1, InStart: 1, InEnd: 2},
+			{OutStart: 1, OutEnd: 2, InStart: 2, InEnd: 2},
+			{OutStart: 2, OutEnd: 3, InStart: 2, InEnd: 3},
+			{OutStart: 3, OutEnd: 4, InStart: 3, InEnd: 4},
+			{OutStart: 4, OutEnd: 5, InStart: 4, InEnd: 5},
+			{OutStart: 5, OutEnd: 6, InStart: 5, InEnd: 6},
+			{OutStart: 6, OutEnd: 6, InStart: 6, InEnd: 7},
+		})
+	})
+
+	t.Run("convert an empty string", func(t *testing.T) {
+		result, spans := stringcase.UpperSpaceCaseWithMapping("", opts)
+		assert.Equal(t, result, "")
+		assert.Equal(t, spans, []stringcase.Span{})
+	})
+}
+
+func TestIsUpperSpaceCase(t *testing.T) {
+	t.Run("string in upper space case", func(t *testing.T) {
+		assert.True(t, stringcase.IsUpperSpaceCase("USER ACCOUNT SETTINGS"))
+		assert.True(t, stringcase.IsUpperSpaceCase(""))
+	})
+
+	t.Run("string not in upper space case", func(t *testing.T) {
+		assert.False(t, stringcase.IsUpperSpaceCase("user_account_settings"))
+		assert.False(t, stringcase.IsUpperSpaceCase("USER ACCOUNT SETTINGS_"))
+		assert.False(t, stringcase.IsUpperSpaceCase("RATE% LIMIT"))
+	})
+}
+
+func TestIsUpperSpaceCaseWithOptions(t *testing.T) {
+	opts := stringcase.Options{SeparateAfterNonAlphabets: true, Keep: "%"}
+
+	t.Run("string in upper space case", func(t *testing.T) {
+		assert.True(t, stringcase.IsUpperSpaceCaseWithOptions("RATE% LIMIT", opts))
+		assert.True(t, stringcase.IsUpperSpaceCaseWithOptions("USER ACCOUNT SETTINGS", opts))
+	})
+
+	t.Run("string not in upper space case", func(t *testing.T) {
+		assert.False(t, stringcase.IsUpperSpaceCaseWithOptions("user_account_settings", opts))
+		assert.False(t, stringcase.IsUpperSpaceCaseWithOptions("USER ACCOUNT SETTINGS_", opts))
+	})
+}
+
+func TestAppendUpperSpaceCase(t *testing.T) {
+	t.Run("append to a buffer", func(t *testing.T) {
+		buf := stringcase.AppendUpperSpaceCase([]byte("key="), "userAccount-settings")
+		assert.Equal(t, string(buf), "key=USER ACCOUNT SETTINGS")
+
+		buf = stringcase.AppendUpperSpaceCase(nil, "userAccount-settings")
+		assert.Equal(t, string(buf), "USER ACCOUNT SETTINGS")
+	})
+
+	t.Run("same result as UpperSpaceCase", func(t *testing.T) {
+		input := "  fooBar100%baz. hello__World!"
+		buf := stringcase.AppendUpperSpaceCase(nil, input)
+		assert.Equal(t, string(buf), stringcase.UpperSpaceCase(input))
+	})
+
+	t.Run("do not allocate memory", func(t *testing.T) {
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = stringcase.AppendUpperSpaceCase(buf[:0], "userAccount-settings. fooBar100%baz")
+		})
+		assert.Equal(t, allocs, 0.0)
+	})
+}
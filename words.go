@@ -0,0 +1,41 @@
+// Copyright (C) 2026 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package stringcase
+
+// Words splits the input string into words at the same word boundaries as the conversion functions
+// find with the given options, and returns them without changing their letter cases.
+//
+// Separator characters are removed and never belong to any word, while kept characters, such as
+// ASCII digits and the characters specified in opts.Keep, belong to the adjacent words according to
+// opts.SeparateBeforeNonAlphabets and opts.SeparateAfterNonAlphabets, or opts.Boundaries. If
+// opts.Normalization or opts.FoldToASCII is specified, the words are taken from the input string
+// after normalization and folding. A word in opts.ProtectedWords is returned as a single word with
+// the spelling in the input string.
+//
+// This function returns an empty slice when the input string is empty or has only separators.
+func Words(input string, opts Options) []string {
+	input = opts.preprocess(input)
+
+	words := make([]string, 0, 4)
+	wordStart, wordEnd := -1, -1
+
+	for _, token := range appendTokens(make([]Token, 0, 8), input, &opts) {
+		if token.Kind == TokenSeparator {
+			continue
+		}
+		if token.WordStart {
+			if wordStart >= 0 {
+				words = append(words, input[wordStart:wordEnd])
+			}
+			wordStart = token.Start
+		}
+		wordEnd = token.End
+	}
+
+	if wordStart >= 0 {
+		words = append(words, input[wordStart:wordEnd])
+	}
+	return words
+}
@@ -0,0 +1,139 @@
+package stringcase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sttk/stringcase"
+)
+
+func TestWords(t *testing.T) {
+	origOpts := stringcase.Options{
+		SeparateBeforeNonAlphabets: false,
+		SeparateAfterNonAlphabets:  true,
+	}
+
+	t.Run("split at word boundaries", func(t *testing.T) {
+		opts := origOpts
+
+		result := stringcase.Words("fooBarBaz", opts)
+		assert.Equal(t, result, []string{"foo", "Bar", "Baz"})
+
+		result = stringcase.Words("__HTTPServer--foo100bar__", opts)
+		assert.Equal(t, result, []string{"HTTP", "Server", "foo100", "bar"})
+
+		result = stringcase.Words("foo#Bar100baz", opts)
+		assert.Equal(t, result, []string{"foo", "Bar100", "baz"})
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result := stringcase.Words("", origOpts)
+		assert.Equal(t, result, []string{})
+
+		result = stringcase.Words("-_-", origOpts)
+		assert.Equal(t, result, []string{})
+	})
+
+	t.Run("separate before and after non-alphabets", func(t *testing.T) {
+		opts := origOpts
+		opts.SeparateBeforeNonAlphabets = true
+
+		result := stringcase.Words("foo100Bar200baz", opts)
+		assert.Equal(t, result, []string{"foo", "100", "Bar", "200", "baz"})
+
+		opts.SeparateAfterNonAlphabets = false
+		result = stringcase.Words("foo100Bar200baz", opts)
+		assert.Equal(t, result, []string{"foo", "100", "Bar", "200baz"})
+	})
+
+	t.Run("separators and kept characters", func(t *testing.T) {
+		opts := origOpts
+		opts.Separators = "-"
+
+		result := stringcase.Words("foo-bar#baz", opts)
+		assert.Equal(t, result, []string{"foo", "bar#", "baz"})
+
+		opts = origOpts
+		opts.Keep = "."
+		result = stringcase.Words("v1.2_release", opts)
+		assert.Equal(t, result, []string{"v1.2", "release"})
+	})
+
+	t.Run("with other options", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.Normalization = stringcase.NormalizationNFC
+
+		result := stringcase.Words("CrèmeBrûlée", opts)
+		assert.Equal(t, result, []string{"Crème", "Brûlée"})
+
+		opts.FoldToASCII = true
+		result = stringcase.Words("CrèmeBrûlée", opts)
+		assert.Equal(t, result, []string{"Creme", "Brulee"})
+
+		opts = origOpts
+		opts.AcronymPlurals = true
+		result = stringcase.Words("userIDsAndAPIsList", opts)
+		assert.Equal(t, result, []string{"user", "IDs", "And", "APIs", "List"})
+
+		opts = origOpts
+		opts.ProtectedWords = stringcase.NewWordList("iOS", "C++")
+		result = stringcase.Words("IOSApp_for_c++Dev", opts)
+		assert.Equal(t, result, []string{"IOS", "App", "for", "c++", "Dev"})
+
+		opts = origOpts
+		opts.Boundaries = stringcase.BoundaryLowerUpper
+		result = stringcase.Words("HTTPServer100FooBar", opts)
+		assert.Equal(t, result, []string{"HTTPServer100Foo", "Bar"})
+
+		opts.Boundaries = stringcase.BoundaryNone
+		result = stringcase.Words("fooBar_BAZQux", opts)
+		assert.Equal(t, result, []string{"fooBar", "BAZQux"})
+	})
+
+	t.Run("with scripts", func(t *testing.T) {
+		opts := origOpts
+		opts.Unicode = true
+		opts.SeparateScripts = true
+
+		result := stringcase.Words("\u0391\u0392cd\u30ad\u30fc\u6f22\u5b57", opts)
+		assert.Equal(t, result, []string{"\u0391", "\u0392", "cd", "\u30ad\u30fc", "\u6f22\u5b57"})
+
+		result = stringcase.Words("100Foo_\u30ad\u30fc", opts)
+		assert.Equal(t, result, []string{"100", "Foo", "\u30ad\u30fc"})
+
+		result = stringcase.Words("\u30ad\u30fc_Foo", opts)
+		assert.Equal(t, result, []string{"\u30ad\u30fc", "Foo"})
+
+		opts.Locale = stringcase.LocaleDutch
+		result = stringcase.Words("IJsselMeer", opts)
+		assert.Equal(t, result, []string{"IJssel", "Meer"})
+
+		opts.ProtectedWords = stringcase.NewWordList("iOS")
+		result = stringcase.Words("\u0391\u0392ios_\u30ad\u30fc", opts)
+		assert.Equal(t, result, []string{"\u0391", "\u0392", "ios", "\u30ad\u30fc"})
+	})
+
+	t.Run("same as the conversion functions", func(t *testing.T) {
+		inputs := []string{
+			"fooBarBaz", "FOO_BAR_BAZ", "XMLHttpRequest", "abc123Def456", "--a-b-c--",
+			"foo#Bar100baz", "ABC123DEF", "a1B2c3", "MyXMLParser2Go",
+		}
+		for _, before := range []bool{false, true} {
+			for _, after := range []bool{false, true} {
+				opts := stringcase.Options{
+					SeparateBeforeNonAlphabets: before,
+					SeparateAfterNonAlphabets:  after,
+					Keep:                       "#",
+				}
+				for _, input := range inputs {
+					words := stringcase.Words(input, opts)
+					result := strings.ToLower(strings.Join(words, "_"))
+					assert.Equal(t, result, stringcase.SnakeCaseWithOptions(input, opts))
+				}
+			}
+		}
+	})
+}